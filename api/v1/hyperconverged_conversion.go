@@ -0,0 +1,5 @@
+package v1
+
+// Hub marks this type as a conversion hub. All the other served versions of the HyperConverged API are converted to
+// and from this version.
+func (*HyperConverged) Hub() {}
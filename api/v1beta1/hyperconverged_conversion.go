@@ -0,0 +1,232 @@
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+)
+
+// ConversionDataAnnotation is set on the v1 HyperConverged, to preserve the v1beta1 fields that were removed from the
+// v1 API. The annotation is removed when converting back to v1beta1, so the round trip is lossless.
+const ConversionDataAnnotation = "hco.kubevirt.io/v1beta1-conversion-data"
+
+// removedSpecFields holds the v1beta1 spec fields with no equivalent in the v1 API. The json tags must match the ones
+// of HyperConvergedSpec, because this struct is populated from, and merged back into, the spec json.
+type removedSpecFields struct {
+	LocalStorageClassName    string                 `json:"localStorageClassName,omitempty"`
+	TektonPipelinesNamespace *string                `json:"tektonPipelinesNamespace,omitempty"`
+	TektonTasksNamespace     *string                `json:"tektonTasksNamespace,omitempty"`
+	FeatureGates             *removedFeatureGates   `json:"featureGates,omitempty"`
+	MediatedDevices          *removedMDevFields     `json:"mediatedDevicesConfiguration,omitempty"`
+	NodeMediatedDevices      []removedNodeMDevField `json:"nodeMediatedDeviceTypes,omitempty"`
+}
+
+// removedFeatureGates holds the deprecated v1beta1 feature gates. The json tags must match the ones of
+// HyperConvergedFeatureGates.
+type removedFeatureGates struct {
+	WithHostPassthroughCPU           *bool `json:"withHostPassthroughCPU,omitempty"`
+	EnableCommonBootImageImport      *bool `json:"enableCommonBootImageImport,omitempty"`
+	DeployTektonTaskResources        *bool `json:"deployTektonTaskResources,omitempty"`
+	DeployVMConsoleProxy             *bool `json:"deployVmConsoleProxy,omitempty"`
+	DeployKubevirtIpamController     *bool `json:"deployKubevirtIpamController,omitempty"`
+	NonRoot                          *bool `json:"nonRoot,omitempty"`
+	EnableManagedTenantQuota         *bool `json:"enableManagedTenantQuota,omitempty"`
+	AutoResourceLimits               *bool `json:"autoResourceLimits,omitempty"`
+	EnableApplicationAwareQuota      *bool `json:"enableApplicationAwareQuota,omitempty"`
+	PrimaryUserDefinedNetworkBinding *bool `json:"primaryUserDefinedNetworkBinding,omitempty"`
+}
+
+// removedMDevFields holds the deprecated mediatedDevicesTypes field. Promoted is true if the v1 mediatedDeviceTypes
+// field was populated from the deprecated field, because the v1beta1 mediatedDeviceTypes field was empty.
+type removedMDevFields struct {
+	MediatedDevicesTypes []string `json:"mediatedDevicesTypes,omitempty"`
+	Promoted             bool     `json:"promoted,omitempty"`
+}
+
+// removedNodeMDevField is the same as removedMDevFields, for a specific item of the nodeMediatedDeviceTypes list.
+type removedNodeMDevField struct {
+	Index int `json:"index"`
+	removedMDevFields
+}
+
+var _ conversion.Convertible = &HyperConverged{}
+
+// ConvertTo converts this HyperConverged to the Hub version (v1).
+func (hc *HyperConverged) ConvertTo(dstRaw conversion.Hub) error {
+	dst, ok := dstRaw.(*hcov1.HyperConverged)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub type %T", dstRaw)
+	}
+
+	hc.ObjectMeta.DeepCopyInto(&dst.ObjectMeta)
+
+	dst.Spec = hcov1.HyperConvergedSpec{}
+	if err := convertViaJSON(hc.Spec, &dst.Spec); err != nil {
+		return fmt.Errorf("failed to convert the HyperConverged spec to %s; %w", hcov1.SchemeGroupVersion, err)
+	}
+
+	dst.Status = hcov1.HyperConvergedStatus{}
+	if err := convertViaJSON(hc.Status, &dst.Status); err != nil {
+		return fmt.Errorf("failed to convert the HyperConverged status to %s; %w", hcov1.SchemeGroupVersion, err)
+	}
+
+	removed := &removedSpecFields{}
+	if err := convertViaJSON(hc.Spec, removed); err != nil {
+		return fmt.Errorf("failed to read the removed HyperConverged fields; %w", err)
+	}
+
+	if removed.FeatureGates != nil && reflect.DeepEqual(*removed.FeatureGates, removedFeatureGates{}) {
+		removed.FeatureGates = nil
+	}
+	removed.MediatedDevices, removed.NodeMediatedDevices = promoteDeprecatedMDevTypes(hc.Spec.MediatedDevicesConfiguration, dst.Spec.MediatedDevicesConfiguration)
+
+	if reflect.DeepEqual(*removed, removedSpecFields{}) {
+		return nil
+	}
+
+	data, err := json.Marshal(removed)
+	if err != nil {
+		return fmt.Errorf("failed to store the removed HyperConverged fields; %w", err)
+	}
+
+	if dst.Annotations == nil {
+		dst.Annotations = make(map[string]string)
+	}
+	dst.Annotations[ConversionDataAnnotation] = string(data)
+
+	return nil
+}
+
+// ConvertFrom converts from the Hub version (v1) to this version.
+func (hc *HyperConverged) ConvertFrom(srcRaw conversion.Hub) error {
+	src, ok := srcRaw.(*hcov1.HyperConverged)
+	if !ok {
+		return fmt.Errorf("unsupported conversion hub type %T", srcRaw)
+	}
+
+	src.ObjectMeta.DeepCopyInto(&hc.ObjectMeta)
+
+	hc.Spec = HyperConvergedSpec{}
+	if err := convertViaJSON(src.Spec, &hc.Spec); err != nil {
+		return fmt.Errorf("failed to convert the HyperConverged spec from %s; %w", hcov1.SchemeGroupVersion, err)
+	}
+
+	hc.Status = HyperConvergedStatus{}
+	if err := convertViaJSON(src.Status, &hc.Status); err != nil {
+		return fmt.Errorf("failed to convert the HyperConverged status from %s; %w", hcov1.SchemeGroupVersion, err)
+	}
+
+	data, found := hc.Annotations[ConversionDataAnnotation]
+	if !found {
+		return nil
+	}
+
+	delete(hc.Annotations, ConversionDataAnnotation)
+	if len(hc.Annotations) == 0 {
+		hc.Annotations = nil
+	}
+
+	removed := &removedSpecFields{}
+	if err := json.Unmarshal([]byte(data), removed); err != nil {
+		return fmt.Errorf("failed to read the %s annotation; %w", ConversionDataAnnotation, err)
+	}
+
+	// the json of removedSpecFields is a subset of the HyperConvergedSpec json, so unmarshalling it into the spec only
+	// sets the removed fields, and keeps all the other fields untouched.
+	if err := convertViaJSON(removedSpecFields{
+		LocalStorageClassName:    removed.LocalStorageClassName,
+		TektonPipelinesNamespace: removed.TektonPipelinesNamespace,
+		TektonTasksNamespace:     removed.TektonTasksNamespace,
+		FeatureGates:             removed.FeatureGates,
+	}, &hc.Spec); err != nil {
+		return fmt.Errorf("failed to restore the removed HyperConverged fields; %w", err)
+	}
+
+	restoreDeprecatedMDevTypes(hc.Spec.MediatedDevicesConfiguration, removed.MediatedDevices, removed.NodeMediatedDevices)
+
+	return nil
+}
+
+// promoteDeprecatedMDevTypes returns the deprecated mediatedDevicesTypes fields of the v1beta1 mediated devices
+// configuration. If only the deprecated field is set, its value is used for the v1 mediatedDeviceTypes field.
+func promoteDeprecatedMDevTypes(src *MediatedDevicesConfiguration, dst *hcov1.MediatedDevicesConfiguration) (*removedMDevFields, []removedNodeMDevField) {
+	if src == nil || dst == nil {
+		return nil, nil
+	}
+
+	var mdevFields *removedMDevFields
+	if len(src.MediatedDevicesTypes) > 0 { //nolint SA1019
+		mdevFields = &removedMDevFields{MediatedDevicesTypes: slices.Clone(src.MediatedDevicesTypes)} //nolint SA1019
+		if len(src.MediatedDeviceTypes) == 0 {
+			dst.MediatedDeviceTypes = slices.Clone(src.MediatedDevicesTypes) //nolint SA1019
+			mdevFields.Promoted = true
+		}
+	}
+
+	var nodeFields []removedNodeMDevField
+	for i, nodeConf := range src.NodeMediatedDeviceTypes {
+		if len(nodeConf.MediatedDevicesTypes) == 0 || i >= len(dst.NodeMediatedDeviceTypes) { //nolint SA1019
+			continue
+		}
+
+		field := removedNodeMDevField{
+			Index:             i,
+			removedMDevFields: removedMDevFields{MediatedDevicesTypes: slices.Clone(nodeConf.MediatedDevicesTypes)}, //nolint SA1019
+		}
+
+		if len(nodeConf.MediatedDeviceTypes) == 0 {
+			dst.NodeMediatedDeviceTypes[i].MediatedDeviceTypes = slices.Clone(nodeConf.MediatedDevicesTypes) //nolint SA1019
+			field.Promoted = true
+		}
+
+		nodeFields = append(nodeFields, field)
+	}
+
+	return mdevFields, nodeFields
+}
+
+// restoreDeprecatedMDevTypes reverts promoteDeprecatedMDevTypes. The deprecated field is only restored if the v1
+// mediatedDeviceTypes field was not modified since the conversion; otherwise the v1 value wins.
+func restoreDeprecatedMDevTypes(mdc *MediatedDevicesConfiguration, mdevFields *removedMDevFields, nodeFields []removedNodeMDevField) {
+	if mdc == nil {
+		return
+	}
+
+	if mdevFields != nil {
+		restoreDeprecatedMDevTypeList(&mdc.MediatedDeviceTypes, &mdc.MediatedDevicesTypes, mdevFields) //nolint SA1019
+	}
+
+	for _, field := range nodeFields {
+		if field.Index < 0 || field.Index >= len(mdc.NodeMediatedDeviceTypes) {
+			continue
+		}
+
+		nodeConf := &mdc.NodeMediatedDeviceTypes[field.Index]
+		restoreDeprecatedMDevTypeList(&nodeConf.MediatedDeviceTypes, &nodeConf.MediatedDevicesTypes, &field.removedMDevFields) //nolint SA1019
+	}
+}
+
+func restoreDeprecatedMDevTypeList(current, deprecated *[]string, fields *removedMDevFields) {
+	if !slices.Equal(*current, fields.MediatedDevicesTypes) {
+		return
+	}
+
+	*deprecated = slices.Clone(fields.MediatedDevicesTypes)
+	if fields.Promoted {
+		*current = nil
+	}
+}
+
+func convertViaJSON(src, dst any) error {
+	data, err := json.Marshal(src)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, dst)
+}
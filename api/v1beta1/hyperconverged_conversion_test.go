@@ -0,0 +1,177 @@
+package v1beta1_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+var _ = Describe("HyperConverged conversion", func() {
+	getV1beta1HC := func() *v1beta1.HyperConverged {
+		return &v1beta1.HyperConverged{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "kubevirt-hyperconverged",
+				Namespace:   "kubevirt-hyperconverged",
+				Annotations: map[string]string{"some-annotation": "some-value"},
+			},
+			Spec: v1beta1.HyperConvergedSpec{
+				FeatureGates: v1beta1.HyperConvergedFeatureGates{
+					DownwardMetrics: ptr.To(true),
+				},
+				EnableCommonBootImageImport: ptr.To(true),
+				DeployVMConsoleProxy:        ptr.To(false),
+				DefaultCPUModel:             ptr.To("Haswell"),
+			},
+			Status: v1beta1.HyperConvergedStatus{
+				SystemHealthStatus: "healthy",
+				Versions:           []v1beta1.Version{{Name: "operator", Version: "1.16.0"}},
+			},
+		}
+	}
+
+	It("should convert to v1, and back to the same v1beta1 object", func() {
+		hc := getV1beta1HC()
+
+		hub := &hcov1.HyperConverged{}
+		Expect(hc.ConvertTo(hub)).To(Succeed())
+
+		Expect(hub.Name).To(Equal(hc.Name))
+		Expect(hub.Namespace).To(Equal(hc.Namespace))
+		Expect(hub.Spec.FeatureGates.DownwardMetrics).To(HaveValue(BeTrue()))
+		Expect(hub.Spec.EnableCommonBootImageImport).To(HaveValue(BeTrue()))
+		Expect(hub.Spec.DeployVMConsoleProxy).To(HaveValue(BeFalse()))
+		Expect(hub.Spec.DefaultCPUModel).To(HaveValue(Equal("Haswell")))
+		Expect(hub.Status.SystemHealthStatus).To(Equal("healthy"))
+		Expect(hub.Status.Versions).To(HaveLen(1))
+		Expect(hub.Annotations).ToNot(HaveKey(v1beta1.ConversionDataAnnotation))
+
+		restored := &v1beta1.HyperConverged{}
+		Expect(restored.ConvertFrom(hub)).To(Succeed())
+		Expect(restored).To(Equal(hc))
+	})
+
+	It("should preserve the removed fields on a round trip", func() {
+		hc := getV1beta1HC()
+		hc.Spec.LocalStorageClassName = "local"                          //nolint SA1019
+		hc.Spec.TektonPipelinesNamespace = ptr.To("pipelines")           //nolint SA1019
+		hc.Spec.FeatureGates.EnableCommonBootImageImport = ptr.To(false) //nolint SA1019
+		hc.Spec.FeatureGates.NonRoot = ptr.To(true)                      //nolint SA1019
+
+		hub := &hcov1.HyperConverged{}
+		Expect(hc.ConvertTo(hub)).To(Succeed())
+		Expect(hub.Annotations).To(HaveKey(v1beta1.ConversionDataAnnotation))
+		Expect(hub.Annotations).To(HaveKeyWithValue("some-annotation", "some-value"))
+
+		restored := &v1beta1.HyperConverged{}
+		Expect(restored.ConvertFrom(hub)).To(Succeed())
+		Expect(restored).To(Equal(hc))
+	})
+
+	It("should not leave an empty annotation map after a round trip", func() {
+		hc := getV1beta1HC()
+		hc.Annotations = nil
+		hc.Spec.FeatureGates.NonRoot = ptr.To(true) //nolint SA1019
+
+		hub := &hcov1.HyperConverged{}
+		Expect(hc.ConvertTo(hub)).To(Succeed())
+		Expect(hub.Annotations).To(HaveLen(1))
+
+		restored := &v1beta1.HyperConverged{}
+		Expect(restored.ConvertFrom(hub)).To(Succeed())
+		Expect(restored.Annotations).To(BeNil())
+		Expect(restored).To(Equal(hc))
+	})
+
+	Context("mediated devices", func() {
+		It("should use the deprecated mediatedDevicesTypes field, if mediatedDeviceTypes is empty", func() {
+			hc := getV1beta1HC()
+			hc.Spec.MediatedDevicesConfiguration = &v1beta1.MediatedDevicesConfiguration{
+				MediatedDevicesTypes: []string{"nvidia-222", "nvidia-230"}, //nolint SA1019
+				NodeMediatedDeviceTypes: []v1beta1.NodeMediatedDeviceTypesConfig{
+					{
+						NodeSelector:         map[string]string{"someLabel": "someValue"},
+						MediatedDevicesTypes: []string{"nvidia-223"}, //nolint SA1019
+					},
+					{
+						NodeSelector:        map[string]string{"otherLabel": "otherValue"},
+						MediatedDeviceTypes: []string{"nvidia-224"},
+					},
+				},
+			}
+
+			hub := &hcov1.HyperConverged{}
+			Expect(hc.ConvertTo(hub)).To(Succeed())
+			Expect(hub.Spec.MediatedDevicesConfiguration).ToNot(BeNil())
+			Expect(hub.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-222", "nvidia-230"}))
+			Expect(hub.Spec.MediatedDevicesConfiguration.NodeMediatedDeviceTypes).To(HaveLen(2))
+			Expect(hub.Spec.MediatedDevicesConfiguration.NodeMediatedDeviceTypes[0].MediatedDeviceTypes).To(Equal([]string{"nvidia-223"}))
+			Expect(hub.Spec.MediatedDevicesConfiguration.NodeMediatedDeviceTypes[1].MediatedDeviceTypes).To(Equal([]string{"nvidia-224"}))
+
+			restored := &v1beta1.HyperConverged{}
+			Expect(restored.ConvertFrom(hub)).To(Succeed())
+			Expect(restored).To(Equal(hc))
+		})
+
+		It("should keep both fields, if both are set", func() {
+			hc := getV1beta1HC()
+			hc.Spec.MediatedDevicesConfiguration = &v1beta1.MediatedDevicesConfiguration{
+				MediatedDeviceTypes:  []string{"nvidia-222"},
+				MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+			}
+
+			hub := &hcov1.HyperConverged{}
+			Expect(hc.ConvertTo(hub)).To(Succeed())
+			Expect(hub.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-222"}))
+
+			restored := &v1beta1.HyperConverged{}
+			Expect(restored.ConvertFrom(hub)).To(Succeed())
+			Expect(restored).To(Equal(hc))
+		})
+
+		It("should drop the deprecated field, if the v1 field was modified", func() {
+			hc := getV1beta1HC()
+			hc.Spec.MediatedDevicesConfiguration = &v1beta1.MediatedDevicesConfiguration{
+				MediatedDevicesTypes: []string{"nvidia-222"}, //nolint SA1019
+			}
+
+			hub := &hcov1.HyperConverged{}
+			Expect(hc.ConvertTo(hub)).To(Succeed())
+
+			hub.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes = []string{"nvidia-230"}
+
+			restored := &v1beta1.HyperConverged{}
+			Expect(restored.ConvertFrom(hub)).To(Succeed())
+			Expect(restored.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-230"}))
+			Expect(restored.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes).To(BeEmpty()) //nolint SA1019
+		})
+	})
+
+	It("should convert a v1 object that was not created by a conversion", func() {
+		hub := &hcov1.HyperConverged{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "kubevirt-hyperconverged",
+				Namespace: "kubevirt-hyperconverged",
+			},
+			Spec: hcov1.HyperConvergedSpec{
+				FeatureGates: hcov1.HyperConvergedFeatureGates{
+					AlignCPUs: ptr.To(true),
+				},
+				MediatedDevicesConfiguration: &hcov1.MediatedDevicesConfiguration{
+					MediatedDeviceTypes: []string{"nvidia-222"},
+				},
+			},
+		}
+
+		hc := &v1beta1.HyperConverged{}
+		Expect(hc.ConvertFrom(hub)).To(Succeed())
+		Expect(hc.Spec.FeatureGates.AlignCPUs).To(HaveValue(BeTrue()))
+		Expect(hc.Spec.FeatureGates.NonRoot).To(BeNil()) //nolint SA1019
+		Expect(hc.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes).To(Equal([]string{"nvidia-222"}))
+		Expect(hc.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes).To(BeEmpty()) //nolint SA1019
+	})
+})
@@ -277,6 +277,10 @@ type HyperConvergedSpec struct {
 	// max guest memory and max hotplug ratio. This setting can affect VM CPU and memory settings.
 	// +optional
 	LiveUpdateConfiguration *v1.LiveUpdateConfiguration `json:"liveUpdateConfiguration,omitempty"`
+
+	// Monitoring holds the configuration of the HCO alerts
+	// +optional
+	Monitoring *MonitoringConfig `json:"monitoring,omitempty"`
 }
 
 // CertRotateConfigCA contains the tunables for TLS certificates.
@@ -771,6 +775,20 @@ type HyperConvergedStatus struct {
 
 	// NodeInfo holds information about the cluster nodes
 	NodeInfo NodeInfoStatus `json:"nodeInfo,omitempty"`
+
+	// UpgradePatches reports the outcome of the upgrade patches that were evaluated during the last HCO upgrade.
+	// +optional
+	UpgradePatches *UpgradePatchesStatus `json:"upgradePatches,omitempty"`
+
+	// OperandVersions lists the versions the operands report, against the versions HCO deploys; e.g. KubeVirt or
+	// CDI.
+	// +listType=atomic
+	// +optional
+	OperandVersions []OperandVersion `json:"operandVersions,omitempty"`
+
+	// Upgrade reports the progress of the current HCO upgrade, or the last one if no upgrade is in progress.
+	// +optional
+	Upgrade *UpgradeStatus `json:"upgrade,omitempty"`
 }
 
 type Version struct {
@@ -830,6 +848,85 @@ type NodeInfoStatus struct {
 	ControlPlaneArchitectures []string `json:"controlPlaneArchitectures,omitempty"`
 }
 
+// OperandVersion is the observed version of an operand, against its target version
+type OperandVersion struct {
+	// Name is the kind of the operand CR; e.g. KubeVirt.
+	Name string `json:"name"`
+
+	// ObservedVersion is the version the operand reports in its status. It is empty until the operand is deployed.
+	// +optional
+	ObservedVersion string `json:"observedVersion,omitempty"`
+
+	// TargetVersion is the version HCO deploys.
+	// +optional
+	TargetVersion string `json:"targetVersion,omitempty"`
+}
+
+// UpgradeStatus describes the progress of an HCO upgrade
+type UpgradeStatus struct {
+	// SourceVersion is the HCO version the upgrade started from.
+	SourceVersion string `json:"sourceVersion"`
+
+	// TargetVersion is the HCO version the upgrade moves to.
+	TargetVersion string `json:"targetVersion"`
+
+	// StartTime is the time HCO started the upgrade.
+	StartTime metav1.Time `json:"startTime"`
+
+	// CompletionTime is the time the upgrade was completed. It is not set while the upgrade is in progress.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// BlockingOperand is the first operand that is not upgraded yet, as <kind>/<name>. It is empty if no operand
+	// blocks the completion of the upgrade.
+	// +optional
+	BlockingOperand string `json:"blockingOperand,omitempty"`
+}
+
+// UpgradePatchesStatus holds the outcome of the upgrade patches evaluated during an HCO upgrade
+type UpgradePatchesStatus struct {
+	// SourceVersion is the HCO version the upgrade started from.
+	SourceVersion string `json:"sourceVersion"`
+
+	// TargetVersion is the HCO version the upgrade moved to.
+	TargetVersion string `json:"targetVersion"`
+
+	// Patches is the list of the upgrade patch outcomes, in the order the patches were evaluated.
+	// +listType=atomic
+	// +optional
+	Patches []UpgradePatchResult `json:"patches,omitempty"`
+}
+
+// UpgradePatchOutcome is the result of evaluating a single upgrade patch
+type UpgradePatchOutcome string
+
+const (
+	// UpgradePatchApplied means that the patch was applied to the HyperConverged CR
+	UpgradePatchApplied UpgradePatchOutcome = "Applied"
+	// UpgradePatchSkipped means that the patch was not applied, because the source version is not in the patch
+	// semver range, or because one of the patch preconditions was not met
+	UpgradePatchSkipped UpgradePatchOutcome = "Skipped"
+	// UpgradePatchTestFailed means that the patch was not applied, because one of its "test" operations failed
+	UpgradePatchTestFailed UpgradePatchOutcome = "TestFailed"
+)
+
+// UpgradePatchResult describes the outcome of a single upgrade patch
+type UpgradePatchResult struct {
+	// Index is the position of the patch in the upgrade patch list.
+	Index int32 `json:"index"`
+
+	// SemverRange is the range of the source HCO versions the patch is relevant for.
+	SemverRange string `json:"semverRange"`
+
+	// Outcome is the result of evaluating the patch.
+	// +kubebuilder:validation:Enum=Applied;Skipped;TestFailed
+	Outcome UpgradePatchOutcome `json:"outcome"`
+
+	// Message is a human-readable explanation of the outcome; e.g. why the patch was skipped.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
 // ApplicationAwareConfigurations holds the AAQ configurations
 // +k8s:openapi-gen=true
 type ApplicationAwareConfigurations struct {
@@ -847,6 +944,174 @@ type ApplicationAwareConfigurations struct {
 	AllowApplicationAwareClusterResourceQuota bool `json:"allowApplicationAwareClusterResourceQuota,omitempty"`
 }
 
+// MonitoringConfig holds the configuration of the HCO alerts
+type MonitoringConfig struct {
+	// Silences is a list of Alertmanager silences. HCO creates them, and renews them for as long as they are listed
+	// here. Once a silence is removed from this list, HCO expires it.
+	// +optional
+	Silences []AlertSilence `json:"silences,omitempty"`
+
+	// Alerts is a list of overrides of the alerts that HCO deploys. Each override modifies all the rules of the alert
+	// with the same name.
+	// +optional
+	Alerts []AlertOverride `json:"alerts,omitempty"`
+
+	// Alertmanager configures the Alertmanager that HCO manages the silences in. On OpenShift, the Alertmanager of the
+	// cluster monitoring stack is used if not set. On other clusters, the silences are not managed if not set.
+	// +optional
+	Alertmanager *AlertmanagerConfig `json:"alertmanager,omitempty"`
+
+	// RunbookBaseURL is the base URL of the runbooks of the alerts that HCO deploys. The runbook_url annotation of each
+	// alert is set to the base URL, followed by the name of the alert; e.g. "https://wiki.example.com/runbooks" sets
+	// the runbook of the HCOInstallationIncomplete alert to "https://wiki.example.com/runbooks/HCOInstallationIncomplete".
+	// The upstream runbooks are used if not set.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	RunbookBaseURL string `json:"runbookBaseURL,omitempty"`
+}
+
+// AlertmanagerConfig is the connection configuration of an Alertmanager. Exactly one of url or serviceSelector must be
+// set. The referenced Secrets and ConfigMaps must be in the namespace of HCO.
+// +kubebuilder:validation:XValidation:rule="has(self.url) != has(self.serviceSelector)",message="exactly one of url or serviceSelector must be set"
+type AlertmanagerConfig struct {
+	// URL is the base URL of the Alertmanager API; e.g. "https://alertmanager.monitoring.svc:9093"
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	URL string `json:"url,omitempty"`
+
+	// ServiceSelector discovers the Alertmanager by the labels of its Service
+	// +optional
+	ServiceSelector *AlertmanagerServiceSelector `json:"serviceSelector,omitempty"`
+
+	// BasicAuthSecret is the name of a Secret with the "username" and the "password" keys. If set, HCO authenticates
+	// to Alertmanager with basic authentication.
+	// +optional
+	BasicAuthSecret string `json:"basicAuthSecret,omitempty"`
+
+	// TLS configures the TLS connection to Alertmanager
+	// +optional
+	TLS *AlertmanagerTLSConfig `json:"tls,omitempty"`
+}
+
+// AlertmanagerServiceSelector selects the Service of an Alertmanager
+type AlertmanagerServiceSelector struct {
+	// Namespace is the namespace of the Alertmanager Service
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// MatchLabels are the labels of the Alertmanager Service. If several Services match, the first one by name is used.
+	// +kubebuilder:validation:MinProperties=1
+	MatchLabels map[string]string `json:"matchLabels"`
+
+	// Port is the name of the Service port of the Alertmanager API. The first port of the Service is used if not set.
+	// +optional
+	Port string `json:"port,omitempty"`
+}
+
+// AlertmanagerTLSConfig is the TLS configuration of the connection to Alertmanager. When the Alertmanager is
+// discovered by its Service, the connection uses HTTPS if the TLS configuration is set, and HTTP otherwise.
+type AlertmanagerTLSConfig struct {
+	// CAConfigMap is the name of a ConfigMap with the CA bundle that verifies the Alertmanager certificate, in the
+	// "ca-bundle.crt" key. The system CAs are used if not set.
+	// +optional
+	CAConfigMap string `json:"caConfigMap,omitempty"`
+
+	// ClientCertSecret is the name of a kubernetes.io/tls Secret with the client certificate and key. If set, HCO
+	// authenticates to Alertmanager with mutual TLS.
+	// +optional
+	ClientCertSecret string `json:"clientCertSecret,omitempty"`
+
+	// ServerName is the expected server name of the Alertmanager certificate. The host of the URL is used if not set.
+	// +optional
+	ServerName string `json:"serverName,omitempty"`
+}
+
+// AlertOverride modifies an alert of the PrometheusRules that HCO deploys
+type AlertOverride struct {
+	// Name is the name of the alert; e.g. HCOInstallationIncomplete
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Disabled removes the alert from the PrometheusRule
+	// +optional
+	Disabled bool `json:"disabled,omitempty"`
+
+	// Severity overrides the severity label of the alert
+	// +kubebuilder:validation:Enum=critical;warning;info
+	// +optional
+	Severity string `json:"severity,omitempty"`
+
+	// For overrides the duration the alert condition must be true, before the alert fires. "0s" fires the alert as
+	// soon as its condition is true.
+	// +optional
+	For *metav1.Duration `json:"for,omitempty"`
+
+	// Threshold overrides the threshold of the alert, for the alerts that have a tunable threshold. The threshold is a
+	// decimal number; e.g. "0.8".
+	// +kubebuilder:validation:Pattern=^[0-9]+(\.[0-9]+)?$
+	// +optional
+	Threshold *string `json:"threshold,omitempty"`
+
+	// Labels are added to the labels of the alert; e.g. to route the alert to a specific receiver. The severity,
+	// operator_health_impact, kubernetes_operator_part_of and kubernetes_operator_component labels can't be set here.
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// RunbookURL overrides the runbook_url annotation of the alert. It takes precedence over the runbookBaseURL.
+	// +kubebuilder:validation:Pattern=`^https?://`
+	// +optional
+	RunbookURL string `json:"runbookURL,omitempty"`
+}
+
+// AlertSilence is an Alertmanager silence, managed by HCO
+type AlertSilence struct {
+	// Name identifies the silence. It must be unique in the silences list.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Matchers select the silenced alerts. An alert is silenced if it matches all the matchers.
+	// +kubebuilder:validation:MinItems=1
+	Matchers []AlertSilenceMatcher `json:"matchers"`
+
+	// Duration is the duration of the silence. HCO renews the silence when half of its duration has passed, or when
+	// less than 2h are left, so the alerts stay silenced for as long as the silence is listed. The minimal duration is 2h.
+	// +kubebuilder:default="24h"
+	// +default="24h"
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Comment is the reason for the silence
+	// +kubebuilder:validation:MinLength=1
+	Comment string `json:"comment"`
+}
+
+// AlertSilenceMatchOperator is the operator of an AlertSilenceMatcher
+// +kubebuilder:validation:Enum="=";"!=";"=~";"!~"
+type AlertSilenceMatchOperator string
+
+const (
+	AlertSilenceMatchEqual    AlertSilenceMatchOperator = "="
+	AlertSilenceMatchNotEqual AlertSilenceMatchOperator = "!="
+	AlertSilenceMatchRegex    AlertSilenceMatchOperator = "=~"
+	AlertSilenceMatchNotRegex AlertSilenceMatchOperator = "!~"
+)
+
+// AlertSilenceMatcher matches an alert label
+type AlertSilenceMatcher struct {
+	// Name is the name of the alert label
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Value is the value of the alert label, or a regular expression if the operator is "=~" or "!~"
+	Value string `json:"value"`
+
+	// Operator is the match operator; one of "=", "!=", "=~" or "!~"
+	// +kubebuilder:default="="
+	// +default="="
+	// +optional
+	Operator AlertSilenceMatchOperator `json:"operator,omitempty"`
+}
+
 // HigherWorkloadDensity holds configurataion aimed to increase virtual machine density
 type HigherWorkloadDensityConfiguration struct {
 	// MemoryOvercommitPercentage is the percentage of memory we want to give VMIs compared to the amount
@@ -885,13 +1150,17 @@ const (
 	// has been applied to the HyperConverged resource via a specialized annotation.
 	// This condition is exposed only when its value is True, and is otherwise hidden.
 	ConditionTaintedConfiguration = "TaintedConfiguration"
+
+	// ConditionOperandsFrozen indicates that some of the resources maintained by the operator are frozen via the
+	// hco.kubevirt.io/frozen-operands annotation, and are not reconciled.
+	// This condition is exposed only when its value is True, and is otherwise hidden.
+	ConditionOperandsFrozen = "OperandsFrozen"
 )
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // HyperConverged is the Schema for the hyperconvergeds API
 // +k8s:openapi-gen=true
-// +kubebuilder:storageversion
 // +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
 // +kubebuilder:resource:scope=Namespaced,categories={all},shortName={hco,hcos}
 // +kubebuilder:subresource:status
@@ -172,6 +172,22 @@ func SetObjectDefaults_HyperConverged(in *HyperConverged) {
 		var ptrVar1 bool = false
 		in.Spec.EnableApplicationAwareQuota = &ptrVar1
 	}
+	if in.Spec.Monitoring != nil {
+		for i := range in.Spec.Monitoring.Silences {
+			a := &in.Spec.Monitoring.Silences[i]
+			for j := range a.Matchers {
+				b := &a.Matchers[j]
+				if b.Operator == "" {
+					b.Operator = "="
+				}
+			}
+			if a.Duration == nil {
+				if err := json.Unmarshal([]byte(`"24h"`), &a.Duration); err != nil {
+					panic(err)
+				}
+			}
+		}
+	}
 }
 
 func SetObjectDefaults_HyperConvergedList(in *HyperConvergedList) {
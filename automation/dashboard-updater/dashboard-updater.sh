@@ -29,9 +29,15 @@ export KUBECONFIG=/tmp/fake-config
 json_files_dir="$1"
 configmaps_files_dir="$2"
 
-rm -Rf "$configmaps_files_dir"
 mkdir -p "$configmaps_files_dir"
 
+# remove the previously updated configmaps; keep the configmaps that are generated by HCO tools
+for file in "${configmaps_files_dir}"/*.yaml; do
+    if [[ -f "$file" ]] && ! grep -q "^# Code generated by" "$file"; then
+        rm -f "$file"
+    fi
+done
+
 echo "Generating configmaps for dashboards"
 
 for file in "${json_files_dir}"/*.json; do
@@ -218,11 +218,9 @@ func main() {
 		os.Exit(1)
 	}
 
-	if ci.IsOpenshift() {
-		if err = observability.SetupWithManager(mgr, ci.GetDeployment()); err != nil {
-			logger.Error(err, "unable to create controller", "controller", "Observability")
-			os.Exit(1)
-		}
+	if err = observability.SetupWithManager(mgr, ci.GetDeployment(), ci.IsOpenshift()); err != nil {
+		logger.Error(err, "unable to create controller", "controller", "Observability")
+		os.Exit(1)
 	}
 
 	if ci.IsDeschedulerAvailable() {
@@ -10,10 +10,14 @@ import (
 	csvv1alpha1 "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/fields"
 	apiruntime "k8s.io/apimachinery/pkg/runtime"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
@@ -53,6 +57,8 @@ var (
 		kubevirtcorev1.AddToScheme,
 		openshiftconfigv1.Install,
 		csvv1alpha1.AddToScheme,
+		authenticationv1.AddToScheme,
+		authorizationv1.AddToScheme,
 	}
 )
 
@@ -99,6 +105,27 @@ func main() {
 		LivenessEndpointName:   hcoutil.LivenessEndpointName,
 		LeaderElection:         false,
 		Scheme:                 scheme,
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// the workloads are only listed when the HyperConverged CR is deleted, for the deletion inventory, and
+				// the nodes are only listed to propose the node placement defaults; no need to watch them
+				DisableFor: []client.Object{
+					&kubevirtcorev1.VirtualMachine{},
+					&kubevirtcorev1.VirtualMachineInstance{},
+					&cdiv1beta1.DataVolume{},
+					&corev1.PersistentVolumeClaim{},
+					&corev1.Node{},
+				},
+			},
+		},
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				// only the admission rules ConfigMap is read by the webhook
+				&corev1.ConfigMap{}: {
+					Field: fields.Set{"metadata.namespace": operatorNamespace}.AsSelector(),
+				},
+			},
+		},
 		WebhookServer: webhook.NewServer(webhook.Options{
 			CertDir:  webhooks.GetWebhookCertDir(),
 			CertName: hcoutil.WebhookCertName,
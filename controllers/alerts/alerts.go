@@ -9,6 +9,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -20,7 +22,9 @@ const (
 )
 
 type AlertRuleReconciler struct {
-	theRule *promv1.PrometheusRule
+	// baseRule is the PrometheusRule without the runbook base URL and the alert overrides of the HyperConverged CR
+	baseRule *promv1.PrometheusRule
+	theRule  *promv1.PrometheusRule
 }
 
 // newAlertRuleReconciler creates new AlertRuleReconciler instance and returns a pointer to it.
@@ -36,10 +40,27 @@ func newAlertRuleReconciler(namespace string, owner metav1.OwnerReference) (*Ale
 	}
 
 	return &AlertRuleReconciler{
-		theRule: rule,
+		baseRule: rule,
+		theRule:  rule,
 	}, nil
 }
 
+// setHyperConverged applies the runbook base URL and the alert overrides from the HyperConverged CR to the PrometheusRule
+func (r *AlertRuleReconciler) setHyperConverged(hc *hcov1beta1.HyperConverged) error {
+	var monitoring *hcov1beta1.MonitoringConfig
+	if hc != nil {
+		monitoring = hc.Spec.Monitoring
+	}
+
+	rule, err := alertoverrides.Apply(r.baseRule, monitoring, rules.TunableAlerts())
+	if err != nil {
+		return err
+	}
+
+	r.theRule = rule
+	return nil
+}
+
 func (r *AlertRuleReconciler) Kind() string {
 	return promv1.PrometheusRuleKind
 }
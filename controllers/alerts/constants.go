@@ -8,7 +8,8 @@ const (
 	openshiftMonitoringNamespace = "openshift-monitoring"
 )
 
-func getMonitoringNamespace(ci hcoutil.ClusterInfo) string {
+// GetMonitoringNamespace returns the namespace of the cluster monitoring, that scrapes the HCO metrics
+func GetMonitoringNamespace(ci hcoutil.ClusterInfo) string {
 	if ci.IsOpenshift() {
 		return openshiftMonitoringNamespace
 	}
@@ -20,6 +20,7 @@ import (
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
@@ -327,6 +328,73 @@ var _ = Describe("alert tests", func() {
 			Expect(metrics.GetOverwrittenModificationsCount(monitoringv1.PrometheusRuleKind, ruleName)).To(BeEquivalentTo(currentMetric))
 		})
 
+		It("should apply the alert overrides of the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &hcov1beta1.MonitoringConfig{
+				Alerts: []hcov1beta1.AlertOverride{
+					{Name: "HCOInstallationIncomplete", Disabled: true},
+					{Name: "KubeVirtCRModified", Severity: "critical", Labels: map[string]string{"team": "virt"}},
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			var alerts []monitoringv1.Rule
+			for _, group := range pr.Spec.Groups {
+				alerts = append(alerts, group.Rules...)
+			}
+			Expect(alerts).ToNot(ContainElement(HaveField("Alert", "HCOInstallationIncomplete")))
+			Expect(alerts).To(ContainElement(And(
+				HaveField("Alert", "KubeVirtCRModified"),
+				HaveField("Labels", And(HaveKeyWithValue("severity", "critical"), HaveKeyWithValue("team", "virt"))),
+			)))
+
+			By("removing the overrides")
+			hco.Spec.Monitoring = nil
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			newRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, getDeploymentReference(ci.GetDeployment()))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pr.Spec).To(Equal(newRule.Spec))
+		})
+
+		It("should use the runbook URLs of the HyperConverged CR", func() {
+			hco := commontestutils.NewHco()
+			hco.Spec.Monitoring = &hcov1beta1.MonitoringConfig{
+				RunbookBaseURL: "https://wiki.example.com/runbooks",
+				Alerts: []hcov1beta1.AlertOverride{
+					{Name: "KubeVirtCRModified", RunbookURL: "https://wiki.example.com/virt/KubeVirtCRModified"},
+				},
+			}
+			req = commontestutils.NewReq(hco)
+
+			cl := commontestutils.InitClient([]client.Object{ns})
+			r := NewMonitoringReconciler(ci, cl, ee, commontestutils.GetScheme())
+
+			Expect(r.Reconcile(req, false)).To(Succeed())
+			pr := &monitoringv1.PrometheusRule{}
+			Expect(cl.Get(context.Background(), client.ObjectKey{Namespace: r.namespace, Name: ruleName}, pr)).To(Succeed())
+
+			for _, group := range pr.Spec.Groups {
+				for _, rule := range group.Rules {
+					switch rule.Alert {
+					case "":
+					case "KubeVirtCRModified":
+						Expect(rule.Annotations).To(HaveKeyWithValue("runbook_url", "https://wiki.example.com/virt/KubeVirtCRModified"))
+					default:
+						Expect(rule.Annotations).To(HaveKeyWithValue("runbook_url", "https://wiki.example.com/runbooks/"+rule.Alert))
+					}
+				}
+			}
+		})
+
 		It("should use the default runbook URL template when no ENV Variable is set", func() {
 			owner := getDeploymentReference(ci.GetDeployment())
 			promRule, err := rules.BuildPrometheusRule(commontestutils.Namespace, owner)
@@ -807,7 +875,7 @@ var _ = Describe("alert tests", func() {
 			Expect(rb.Subjects).To(HaveLen(1))
 			Expect(rb.Subjects[0].Kind).To(Equal(rbacv1.ServiceAccountKind))
 			Expect(rb.Subjects[0].Name).To(Equal("prometheus-k8s"))
-			Expect(rb.Subjects[0].Namespace).To(Equal(getMonitoringNamespace(ci)))
+			Expect(rb.Subjects[0].Namespace).To(Equal(GetMonitoringNamespace(ci)))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.GetOverwrittenModificationsCount("RoleBinding", roleName)).To(BeEquivalentTo(currentMetric))
@@ -829,7 +897,7 @@ var _ = Describe("alert tests", func() {
 			Expect(rb.Subjects).To(HaveLen(1))
 			Expect(rb.Subjects[0].Kind).To(Equal(rbacv1.ServiceAccountKind))
 			Expect(rb.Subjects[0].Name).To(Equal("prometheus-k8s"))
-			Expect(rb.Subjects[0].Namespace).To(Equal(getMonitoringNamespace(ci)))
+			Expect(rb.Subjects[0].Namespace).To(Equal(GetMonitoringNamespace(ci)))
 
 			Expect(ee.CheckEvents(expectedEvents)).To(BeTrue())
 			Expect(metrics.GetOverwrittenModificationsCount("RoleBinding", roleName)).To(BeEquivalentTo(currentMetric))
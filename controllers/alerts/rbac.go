@@ -181,7 +181,7 @@ func newRoleBinding(owner metav1.OwnerReference, namespace string, ci hcoutil.Cl
 			{
 				Kind:      rbacv1.ServiceAccountKind,
 				Name:      "prometheus-k8s",
-				Namespace: getMonitoringNamespace(ci),
+				Namespace: GetMonitoringNamespace(ci),
 			},
 		},
 	}
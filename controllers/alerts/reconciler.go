@@ -15,6 +15,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -28,6 +29,11 @@ type MetricReconciler interface {
 	UpdateExistingResource(context.Context, client.Client, client.Object, logr.Logger) (client.Object, bool, error)
 }
 
+// hcDependentReconciler is a MetricReconciler that builds its resource from the HyperConverged CR
+type hcDependentReconciler interface {
+	setHyperConverged(hc *hcov1beta1.HyperConverged) error
+}
+
 type MonitoringReconciler struct {
 	reconcilers   []MetricReconciler
 	scheme        *runtime.Scheme
@@ -97,6 +103,12 @@ func (r *MonitoringReconciler) Reconcile(req *common.HcoRequest, firstLoop bool)
 	objects := make([]client.Object, 0, len(r.reconcilers))
 
 	for _, rc := range r.reconcilers {
+		if hcr, ok := rc.(hcDependentReconciler); ok {
+			if err := hcr.setHyperConverged(req.Instance); err != nil {
+				return fmt.Errorf("failed to build the %s: %w", rc.Kind(), err)
+			}
+		}
+
 		obj, err := r.ReconcileOneResource(req, rc, firstLoop)
 		if err != nil {
 			return err
@@ -13,4 +13,43 @@ const (
 	JSONPatchSSPAnnotationName  = "ssp.kubevirt.io/jsonpatch"
 	// Tuning Policy annotation name
 	TuningPolicyAnnotationName = util.HCOAnnotationPrefix + "tuningPolicy"
+	// RestoreUpgradeSnapshotAnnotationName is the annotation to set on the HyperConverged CR, in order to restore its
+	// spec from the snapshot taken before upgrading from the HCO version in the annotation value
+	RestoreUpgradeSnapshotAnnotationName = util.HCOAnnotationPrefix + "restore-upgrade-snapshot"
+	// ServerSideApplyAnnotationName is the annotation to set to "true" on the HyperConverged CR, in order to reconcile
+	// the existing managed resources with server-side apply, instead of updating the whole objects
+	ServerSideApplyAnnotationName = util.HCOAnnotationPrefix + "server-side-apply"
+	// FrozenOperandsAnnotationName is the annotation to set on the HyperConverged CR, with a comma separated list of
+	// <kind>/<name> items, in order to stop reconciling the matching operands
+	FrozenOperandsAnnotationName = util.HCOAnnotationPrefix + "frozen-operands"
+	// OperandEditProtectionAnnotationName is the annotation to set on the HyperConverged CR to either "Deny" or "Warn",
+	// in order to reject, or to warn about, direct modifications of the spec of the KubeVirt, CDI, NetworkAddonsConfig
+	// and SSP CRs
+	OperandEditProtectionAnnotationName = util.HCOAnnotationPrefix + "operand-edit-protection"
+	// DeletionConfirmationTTLAnnotationName is the annotation to set on the HyperConverged CR to a duration, e.g. "10m",
+	// in order to require a confirmation before deleting it. The confirmation is valid for the annotation duration.
+	DeletionConfirmationTTLAnnotationName = util.HCOAnnotationPrefix + "deletion-confirmation-ttl"
+	// DeletionConfirmedAtAnnotationName is the annotation to set on the HyperConverged CR to the current time, in RFC 3339
+	// format, in order to confirm its deletion, if the deletion confirmation TTL annotation is set
+	DeletionConfirmedAtAnnotationName = util.HCOAnnotationPrefix + "deletion-confirmed-at"
+	// NodePlacementDefaultsAnnotationName is the annotation to set to "true" on the HyperConverged CR, in order to make
+	// the mutating webhook propose the missing infra and workloads node placement, from the roles of the cluster nodes
+	NodePlacementDefaultsAnnotationName = util.HCOAnnotationPrefix + "node-placement-defaults"
+
+	// ExtensionLabelName is the label to set to "true" on a ConfigMap in the HCO namespace, in order to make HCO
+	// deploy and reconcile the manifests in the ConfigMap, as additional operands
+	ExtensionLabelName = util.HCOAnnotationPrefix + "extension"
+	// ExtensionAggregateStatusAnnotationName is the annotation to set to "true" on an extension ConfigMap, in order to
+	// aggregate the Available, Progressing and Degraded conditions of its objects into the HyperConverged conditions
+	ExtensionAggregateStatusAnnotationName = util.HCOAnnotationPrefix + "extension-aggregate-status"
+	// ExtensionObjectsAnnotationName is set by HCO on an extension ConfigMap, to track the objects deployed from it
+	ExtensionObjectsAnnotationName = util.HCOAnnotationPrefix + "extension-objects"
+	// ExtensionSourceLabelName is set by HCO on the objects deployed from an extension ConfigMap, with the ConfigMap name
+	ExtensionSourceLabelName = util.HCOAnnotationPrefix + "extension-source"
+	// ExtensionFinalizerName is set by HCO on an extension ConfigMap, to remove its objects when it is deleted
+	ExtensionFinalizerName = util.HCOAnnotationPrefix + "extension-cleanup"
+	// ExtensionNamespacesAnnotationName is the annotation to set on the HyperConverged CR to a comma separated list of
+	// namespaces, in order to allow the extension ConfigMaps to deploy namespaced objects in these namespaces, in
+	// addition to the HCO namespace
+	ExtensionNamespacesAnnotationName = util.HCOAnnotationPrefix + "extension-namespaces"
 )
@@ -0,0 +1,26 @@
+package common
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FieldDrift describes a single field of an operand CR, that was modified out of band and was reverted by HCO
+type FieldDrift struct {
+	// Path is the JSON pointer of the modified field
+	Path string `json:"path"`
+	// Op is the JSON patch operation HCO used to revert the field; one of "add", "remove" or "replace"
+	Op string `json:"op"`
+	// From is the out-of-band value of the field; empty if the field was removed
+	From any `json:"from,omitempty"`
+	// To is the value HCO set back; empty if HCO removed the field
+	To any `json:"to,omitempty"`
+}
+
+// DriftRecord describes a single out-of-band modification of an operand CR, that was overwritten by HCO
+type DriftRecord struct {
+	Kind      string       `json:"kind"`
+	Name      string       `json:"name"`
+	Namespace string       `json:"namespace,omitempty"`
+	Timestamp metav1.Time  `json:"timestamp"`
+	Changes   []FieldDrift `json:"changes"`
+}
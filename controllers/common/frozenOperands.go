@@ -0,0 +1,41 @@
+package common
+
+import (
+	"strings"
+)
+
+// FrozenOperandKey is the kind and the name of a frozen operand. The kind is lower-cased, as the kind in the frozen
+// operands annotation is case-insensitive.
+type FrozenOperandKey struct {
+	Kind string
+	Name string
+}
+
+// NewFrozenOperandKey returns the key of an operand, to compare with the keys in the frozen operands annotation
+func NewFrozenOperandKey(kind, name string) FrozenOperandKey {
+	return FrozenOperandKey{Kind: strings.ToLower(kind), Name: name}
+}
+
+// ParseFrozenOperands parses the value of the frozen operands annotation. The annotation value is a comma separated
+// list of <kind>/<name> items, e.g. "Deployment/kubevirt-console-plugin,DaemonSet/wasp-agent". The kind is
+// case-insensitive. Returns the keys of the frozen operands, and the items that are not in the expected format.
+func ParseFrozenOperands(annotation string) ([]FrozenOperandKey, []string) {
+	var keys []FrozenOperandKey
+	var wrongItems []string
+	for item := range strings.SplitSeq(annotation, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		kind, name, found := strings.Cut(item, "/")
+		if !found || kind == "" || name == "" {
+			wrongItems = append(wrongItems, item)
+			continue
+		}
+
+		keys = append(keys, NewFrozenOperandKey(kind, name))
+	}
+
+	return keys, wrongItems
+}
@@ -0,0 +1,28 @@
+package common
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test ParseFrozenOperands", func() {
+	DescribeTable("should parse the frozen operands annotation", func(annotation string, expectedKeys []FrozenOperandKey, expectedWrongItems []string) {
+		keys, wrongItems := ParseFrozenOperands(annotation)
+		Expect(keys).To(Equal(expectedKeys))
+		Expect(wrongItems).To(Equal(expectedWrongItems))
+	},
+		Entry("empty annotation", "", nil, nil),
+		Entry("single item", "KubeVirt/kubevirt-kubevirt-hyperconverged",
+			[]FrozenOperandKey{{Kind: "kubevirt", Name: "kubevirt-kubevirt-hyperconverged"}}, nil),
+		Entry("several items, with spaces and empty items", " Deployment/kubevirt-console-plugin, ,daemonset/wasp-agent ,",
+			[]FrozenOperandKey{{Kind: "deployment", Name: "kubevirt-console-plugin"}, {Kind: "daemonset", Name: "wasp-agent"}}, nil),
+		Entry("wrong items", "KubeVirt,/name,CDI/,CDI/cdi-kubevirt-hyperconverged",
+			[]FrozenOperandKey{{Kind: "cdi", Name: "cdi-kubevirt-hyperconverged"}}, []string{"KubeVirt", "/name", "CDI/"}),
+	)
+
+	It("should match the keys case-insensitively by kind", func() {
+		keys, _ := ParseFrozenOperands("kubevirt/name")
+		Expect(keys).To(ContainElement(NewFrozenOperandKey("KubeVirt", "name")))
+		Expect(keys).ToNot(ContainElement(NewFrozenOperandKey("KubeVirt", "Name")))
+	})
+})
@@ -22,6 +22,9 @@ type HcoRequest struct {
 	StatusDirty                bool                       // is something was changed in the CR's Status
 	HCOTriggered               bool                       // if the request got triggered by a direct modification on HCO CR
 	Upgradeable                bool                       // if all the operands are upgradeable
+	FrozenOperands             []string                   // the <kind>/<name> of the frozen operands; nil if not checked yet
+	DriftRecords               []DriftRecord              // the out-of-band modifications of operand CRs, overwritten in this request
+	UpgradeBlockingOperand     string                     // in upgrade mode, the <kind>/<name> of the first operand that is not upgraded yet
 }
 
 func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logger, upgradeMode, hcoTriggered bool) *HcoRequest {
@@ -42,4 +45,5 @@ func NewHcoRequest(ctx context.Context, request reconcile.Request, log logr.Logg
 func (req *HcoRequest) SetUpgradeMode(upgradeMode bool) {
 	req.UpgradeMode = upgradeMode
 	req.ComponentUpgradeInProgress = upgradeMode
+	req.UpgradeBlockingOperand = ""
 }
@@ -21,6 +21,8 @@ import (
 	monitoringv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	admissionregistrationv1 "k8s.io/api/admissionregistration/v1"
 	appsv1 "k8s.io/api/apps/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
@@ -179,6 +181,8 @@ func GetScheme() *runtime.Scheme {
 			corev1.AddToScheme,
 			schedulingv1.AddToScheme,
 			admissionregistrationv1.AddToScheme,
+			authenticationv1.AddToScheme,
+			authorizationv1.AddToScheme,
 		} {
 			if err := f(testScheme); err != nil {
 				panic(fmt.Sprintf("failed to add scheme: %T, %v", f, err))
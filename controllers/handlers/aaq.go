@@ -62,6 +62,11 @@ func (*aaqHooks) CheckComponentVersion(cr runtime.Object) bool {
 	return operands.CheckComponentVersion(hcoutil.AaqVersionEnvV, found.Status.ObservedVersion)
 }
 
+func (*aaqHooks) GetComponentVersions(cr runtime.Object) (string, string) {
+	found := cr.(*aaqv1alpha1.AAQ)
+	return operands.GetComponentVersions(hcoutil.AaqVersionEnvV, found.Status.ObservedVersion)
+}
+
 func (h *aaqHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
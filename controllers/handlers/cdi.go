@@ -61,6 +61,11 @@ func (*cdiHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*cdiv1beta1.CDI)
 	return operands.CheckComponentVersion(util.CdiVersionEnvV, found.Status.ObservedVersion)
 }
+
+func (*cdiHooks) GetComponentVersions(cr runtime.Object) (string, string) {
+	found := cr.(*cdiv1beta1.CDI)
+	return operands.GetComponentVersions(util.CdiVersionEnvV, found.Status.ObservedVersion)
+}
 func (h *cdiHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -24,7 +24,6 @@ import (
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
-	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -52,7 +51,7 @@ var (
 	logger = logf.Log.WithName("dataImportCronTemplateInit")
 )
 
-var GetDataImportCronTemplates = func(hc *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+var GetDataImportCronTemplates = func(hc *hcov1beta1.HyperConverged, workloadsArchitectures []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 	crDicts, err := getDicMapFromCr(hc)
 	if err != nil {
 		return nil, err
@@ -66,7 +65,7 @@ var GetDataImportCronTemplates = func(hc *hcov1beta1.HyperConverged) ([]hcov1bet
 
 	if hc.Spec.FeatureGates.EnableMultiArchBootImageImport != nil && *hc.Spec.FeatureGates.EnableMultiArchBootImageImport {
 		for i := range dictList {
-			setDataImportCronTemplateStatusMultiArch(&dictList[i], nodeinfo.GetWorkloadsArchitectures())
+			setDataImportCronTemplateStatusMultiArch(&dictList[i], workloadsArchitectures)
 		}
 	}
 
@@ -92,13 +92,13 @@ var _ = Describe("Test data import cron template", func() {
 				image2.Name: image2,
 			}
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
-			list, err := GetDataImportCronTemplates(hco)
+			list, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(list).To(HaveLen(2))
 			Expect(list).To(ContainElements(statusImage3, statusImage4))
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{}
-			list, err = GetDataImportCronTemplates(hco)
+			list, err = GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(list).To(BeEmpty())
 		})
@@ -112,11 +112,11 @@ var _ = Describe("Test data import cron template", func() {
 			hcoWithNilList.Spec.DataImportCronTemplates = nil
 
 			dataImportCronTemplateHardCodedMap = nil
-			Expect(GetDataImportCronTemplates(hcoWithNilList)).To(BeNil())
-			Expect(GetDataImportCronTemplates(hcoWithEmptyList)).To(BeNil())
+			Expect(GetDataImportCronTemplates(hcoWithNilList, nodeinfo.GetWorkloadsArchitectures())).To(BeNil())
+			Expect(GetDataImportCronTemplates(hcoWithEmptyList, nodeinfo.GetWorkloadsArchitectures())).To(BeNil())
 			dataImportCronTemplateHardCodedMap = make(map[string]hcov1beta1.DataImportCronTemplate)
-			Expect(GetDataImportCronTemplates(hcoWithNilList)).To(BeNil())
-			Expect(GetDataImportCronTemplates(hcoWithEmptyList)).To(BeNil())
+			Expect(GetDataImportCronTemplates(hcoWithNilList, nodeinfo.GetWorkloadsArchitectures())).To(BeNil())
+			Expect(GetDataImportCronTemplates(hcoWithEmptyList, nodeinfo.GetWorkloadsArchitectures())).To(BeNil())
 		})
 
 		It("Should add the CR list to the hard-coded list", func() {
@@ -126,7 +126,7 @@ var _ = Describe("Test data import cron template", func() {
 			}
 			hco.Spec.EnableCommonBootImageImport = ptr.To(true)
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(4))
 			Expect(goldenImageList).To(HaveCap(4))
@@ -147,7 +147,7 @@ var _ = Describe("Test data import cron template", func() {
 			expectedStatus2.Status.Modified = true
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{disabledImage1, enabledImage2, image3, image4}
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(3))
 			Expect(goldenImageList).To(HaveCap(4))
@@ -166,7 +166,7 @@ var _ = Describe("Test data import cron template", func() {
 			enableDict(&image2, &statusImage2)
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image1, image2}
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(1))
 
@@ -188,7 +188,7 @@ var _ = Describe("Test data import cron template", func() {
 			image3.Name = image4.Name
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
-			_, err := GetDataImportCronTemplates(hco)
+			_, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).To(HaveOccurred())
 		})
 
@@ -198,7 +198,7 @@ var _ = Describe("Test data import cron template", func() {
 			image3.Name = image4.Name
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
-			_, err := GetDataImportCronTemplates(hco)
+			_, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).To(HaveOccurred())
 		})
 
@@ -211,7 +211,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.EnableCommonBootImageImport = ptr.To(true)
 			hco.Spec.DataImportCronTemplates = nil
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(2))
 			Expect(goldenImageList).To(HaveCap(2))
@@ -219,7 +219,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			By("CR list is empty")
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{}
-			goldenImageList, err = GetDataImportCronTemplates(hco)
+			goldenImageList, err = GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(2))
 			Expect(goldenImageList).To(ContainElements(statusImage1, statusImage2))
@@ -231,7 +231,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			By("when dataImportCronTemplateHardCodedList is nil")
 			dataImportCronTemplateHardCodedMap = nil
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(2))
 			Expect(goldenImageList).To(HaveCap(2))
@@ -239,7 +239,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			By("when dataImportCronTemplateHardCodedList is empty")
 			dataImportCronTemplateHardCodedMap = map[string]hcov1beta1.DataImportCronTemplate{}
-			goldenImageList, err = GetDataImportCronTemplates(hco)
+			goldenImageList, err = GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(2))
 			Expect(goldenImageList).To(HaveCap(2))
@@ -273,7 +273,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{modifiedImage1, image3, image4}
 
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(4))
 			Expect(goldenImageList).To(HaveCap(4))
@@ -322,7 +322,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*modifiedImage1, image3, image4}
 
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(4))
 			Expect(goldenImageList).To(HaveCap(4))
@@ -355,7 +355,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*modifiedImage1, image3, image4}
 
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(4))
 			Expect(goldenImageList).To(HaveCap(4))
@@ -394,7 +394,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{image3, image4}
 
-			goldenImageStatuses, err := GetDataImportCronTemplates(hco)
+			goldenImageStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			goldenImageList := HCODictSliceToSSP(hco, goldenImageStatuses)
 
@@ -441,7 +441,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*annotationModified, *annotationMissingInCR, *annotationExistsInCR, *annotationMissingInBoth}
 
-			goldenImageStatuses, err := GetDataImportCronTemplates(hco)
+			goldenImageStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			goldenImageList := HCODictSliceToSSP(hco, goldenImageStatuses)
 
@@ -478,7 +478,7 @@ var _ = Describe("Test data import cron template", func() {
 
 			hco.Spec.DataImportCronTemplates = []hcov1beta1.DataImportCronTemplate{*withModifiedNS, image3}
 
-			goldenImageList, err := GetDataImportCronTemplates(hco)
+			goldenImageList, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 			Expect(err).ToNot(HaveOccurred())
 			Expect(goldenImageList).To(HaveLen(3))
 
@@ -617,7 +617,7 @@ var _ = Describe("Test data import cron template", func() {
 				hco.Spec.EnableCommonBootImageImport = ptr.To(true)
 				hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(false)
 
-				dictsStatuses, err := GetDataImportCronTemplates(hco)
+				dictsStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(dictsStatuses).To(HaveLen(4))
 
@@ -641,7 +641,7 @@ var _ = Describe("Test data import cron template", func() {
 				hco.Spec.EnableCommonBootImageImport = ptr.To(true)
 				hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
 
-				dictsStatuses, err := GetDataImportCronTemplates(hco)
+				dictsStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(dictsStatuses).To(HaveLen(4))
 
@@ -674,7 +674,7 @@ var _ = Describe("Test data import cron template", func() {
 				image3.Annotations[MultiArchDICTAnnotation] = "amd64,s390x"
 				image4.Annotations[MultiArchDICTAnnotation] = "amd64,s390x"
 
-				dictsStatuses, err := GetDataImportCronTemplates(hco)
+				dictsStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(dictsStatuses).To(HaveLen(4))
 
@@ -705,7 +705,7 @@ var _ = Describe("Test data import cron template", func() {
 				image2.Annotations[MultiArchDICTAnnotation] = "arm64"
 				image4.Annotations[MultiArchDICTAnnotation] = "arm64"
 
-				dictsStatuses, err := GetDataImportCronTemplates(hco)
+				dictsStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(dictsStatuses).To(HaveLen(4))
 
@@ -748,7 +748,7 @@ var _ = Describe("Test data import cron template", func() {
 				delete(image2.Annotations, MultiArchDICTAnnotation)
 				delete(image4.Annotations, MultiArchDICTAnnotation)
 
-				dictsStatuses, err := GetDataImportCronTemplates(hco)
+				dictsStatuses, err := GetDataImportCronTemplates(hco, nodeinfo.GetWorkloadsArchitectures())
 				Expect(err).ToNot(HaveOccurred())
 				Expect(dictsStatuses).To(HaveLen(4))
 
@@ -235,6 +235,11 @@ func (*kubevirtHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*kubevirtcorev1.KubeVirt)
 	return operands.CheckComponentVersion(hcoutil.KubevirtVersionEnvV, found.Status.ObservedKubeVirtVersion)
 }
+
+func (*kubevirtHooks) GetComponentVersions(cr runtime.Object) (string, string) {
+	found := cr.(*kubevirtcorev1.KubeVirt)
+	return operands.GetComponentVersions(hcoutil.KubevirtVersionEnvV, found.Status.ObservedKubeVirtVersion)
+}
 func (h *kubevirtHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
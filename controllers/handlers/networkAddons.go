@@ -58,6 +58,11 @@ func (h *cnaHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*networkaddonsv1.NetworkAddonsConfig)
 	return operands.CheckComponentVersion(util.CnaoVersionEnvV, found.Status.ObservedVersion)
 }
+
+func (*cnaHooks) GetComponentVersions(cr runtime.Object) (string, string) {
+	found := cr.(*networkaddonsv1.NetworkAddonsConfig)
+	return operands.GetComponentVersions(util.CnaoVersionEnvV, found.Status.ObservedVersion)
+}
 func (h *cnaHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -63,6 +63,11 @@ func (*sspHooks) CheckComponentVersion(cr runtime.Object) bool {
 	found := cr.(*sspv1beta3.SSP)
 	return operands.CheckComponentVersion(util.SspVersionEnvV, found.Status.ObservedVersion)
 }
+
+func (*sspHooks) GetComponentVersions(cr runtime.Object) (string, string) {
+	found := cr.(*sspv1beta3.SSP)
+	return operands.GetComponentVersions(util.SspVersionEnvV, found.Status.ObservedVersion)
+}
 func (h *sspHooks) Reset() {
 	h.Lock()
 	defer h.Unlock()
@@ -105,6 +110,12 @@ func (h *sspHooks) JustBeforeComplete(req *common.HcoRequest) {
 }
 
 func NewSSP(hc *hcov1beta1.HyperConverged) (*sspv1beta3.SSP, []hcov1beta1.DataImportCronTemplateStatus, error) {
+	return NewSSPForArchitectures(hc, nodeinfo.GetControlPlaneArchitectures(), nodeinfo.GetWorkloadsArchitectures())
+}
+
+// NewSSPForArchitectures generates the SSP CR for the given node architectures, rather than for the architectures of
+// the cluster nodes.
+func NewSSPForArchitectures(hc *hcov1beta1.HyperConverged, cpArches, wlArches []string) (*sspv1beta3.SSP, []hcov1beta1.DataImportCronTemplateStatus, error) {
 	templatesNamespace := defaultCommonTemplatesNamespace
 
 	if hc.Spec.CommonTemplatesNamespace != nil {
@@ -113,14 +124,11 @@ func NewSSP(hc *hcov1beta1.HyperConverged) (*sspv1beta3.SSP, []hcov1beta1.DataIm
 
 	goldenimages.ApplyDataImportSchedule(hc)
 
-	dataImportCronStatuses, err := goldenimages.GetDataImportCronTemplates(hc)
+	dataImportCronStatuses, err := goldenimages.GetDataImportCronTemplates(hc, wlArches)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	cpArches := nodeinfo.GetControlPlaneArchitectures()
-	wlArches := nodeinfo.GetWorkloadsArchitectures()
-
 	var cluster *sspv1beta3.Cluster
 	if len(cpArches) > 0 || len(wlArches) > 0 {
 		cluster = &sspv1beta3.Cluster{
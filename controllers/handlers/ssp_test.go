@@ -587,7 +587,7 @@ var _ = Describe("SSP Operands", func() {
 				Expect(hook.cache).To(BeNil())
 
 				origFunc := goldenimages.GetDataImportCronTemplates
-				goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+				goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 					return []hcov1beta1.DataImportCronTemplateStatus{makeDICT(1)}, nil
 				}
 				DeferCleanup(func() {
@@ -652,7 +652,7 @@ var _ = Describe("SSP Operands", func() {
 							goldenimages.MultiArchDICTAnnotation: "noarch1,noarch2",
 						}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -695,7 +695,7 @@ var _ = Describe("SSP Operands", func() {
 						}
 						customDICT.Status.OriginalSupportedArchitectures = "arch2,arch3"
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -756,7 +756,7 @@ var _ = Describe("SSP Operands", func() {
 							OriginalSupportedArchitectures: "noarch1,noarch2",
 						}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -800,14 +800,14 @@ var _ = Describe("SSP Operands", func() {
 							goldenimages.MultiArchDICTAnnotation: "noarch1,noarch2",
 						}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT}, nil
 						}
 
 						ssp, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 						hco.Spec.EnableCommonBootImageImport = ptr.To(true)
@@ -855,14 +855,14 @@ var _ = Describe("SSP Operands", func() {
 							OriginalSupportedArchitectures: "arch2,arch3",
 						}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT}, nil
 						}
 
 						ssp, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 						hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
@@ -924,14 +924,14 @@ var _ = Describe("SSP Operands", func() {
 							OriginalSupportedArchitectures: "noarch1,noarch2",
 						}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT}, nil
 						}
 
 						ssp, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 						hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
@@ -978,7 +978,7 @@ var _ = Describe("SSP Operands", func() {
 						customDICT := makeDICT(2)
 						customDICT.Annotations = map[string]string{}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -1021,7 +1021,7 @@ var _ = Describe("SSP Operands", func() {
 						}
 						customDICT.Status.OriginalSupportedArchitectures = "arch2,arch3"
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -1059,7 +1059,7 @@ var _ = Describe("SSP Operands", func() {
 						customDICT := makeDICT(2)
 						customDICT.Annotations = map[string]string{}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -1101,7 +1101,7 @@ var _ = Describe("SSP Operands", func() {
 						customDICT := makeDICT(2)
 						customDICT.Annotations = map[string]string{}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT}, nil
 						}
 
@@ -1109,7 +1109,7 @@ var _ = Describe("SSP Operands", func() {
 						ssp, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 
@@ -1152,14 +1152,14 @@ var _ = Describe("SSP Operands", func() {
 						customDICT.Annotations = map[string]string{}
 						customDICT.Status.OriginalSupportedArchitectures = "arch2,arch3"
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT}, nil
 						}
 
 						ssp, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 						hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
@@ -1200,14 +1200,14 @@ var _ = Describe("SSP Operands", func() {
 						customDICT := makeDICT(2)
 						customDICT.Annotations = map[string]string{}
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT}, nil
 						}
 
 						ssp, _, err := NewSSP(hco)
 						Expect(err).ToNot(HaveOccurred())
 
-						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
+						goldenimages.GetDataImportCronTemplates = func(_ *hcov1beta1.HyperConverged, _ []string) ([]hcov1beta1.DataImportCronTemplateStatus, error) {
 							return []hcov1beta1.DataImportCronTemplateStatus{commonDICT, customDICT}, nil
 						}
 						hco.Spec.FeatureGates.EnableMultiArchBootImageImport = ptr.To(true)
@@ -0,0 +1,95 @@
+package hyperconverged
+
+import (
+	"encoding/json"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	driftReportConfigMapName = "hco-drift-report"
+
+	// only keep the last few drift records of each operand
+	maxDriftRecordsPerOperand = 10
+)
+
+func getDriftReportKey(record common.DriftRecord) string {
+	return strings.ToLower(record.Kind + "." + record.Name)
+}
+
+// updateDriftReport stores the out-of-band modifications that HCO overwrote in this request, in the drift report
+// ConfigMap. Each operand CR has its own key in the ConfigMap, holding a JSON list of its last drift records. The
+// ConfigMap is only written if there are new records, so writing it does not trigger another update.
+func (r *ReconcileHyperConverged) updateDriftReport(req *common.HcoRequest) {
+	if len(req.DriftRecords) == 0 {
+		return
+	}
+
+	cm := &corev1.ConfigMap{}
+	err := r.client.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: driftReportConfigMapName}, cm)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			req.Logger.Error(err, "failed to read the drift report")
+			return
+		}
+
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      driftReportConfigMapName,
+				Namespace: req.Namespace,
+				Labels:    hcoutil.GetLabels(req.Instance.Name, hcoutil.AppComponentDeployment),
+			},
+		}
+
+		if err = controllerutil.SetControllerReference(req.Instance, cm, r.scheme); err != nil {
+			req.Logger.Error(err, "failed to set the owner of the drift report")
+			return
+		}
+	}
+
+	if cm.Data == nil {
+		cm.Data = make(map[string]string)
+	}
+
+	for _, record := range req.DriftRecords {
+		key := getDriftReportKey(record)
+
+		var records []common.DriftRecord
+		if existing, ok := cm.Data[key]; ok {
+			if err = json.Unmarshal([]byte(existing), &records); err != nil {
+				req.Logger.Error(err, "dropping a malformed drift report entry", "key", key)
+				records = nil
+			}
+		}
+
+		records = append(records, record)
+		if len(records) > maxDriftRecordsPerOperand {
+			records = records[len(records)-maxDriftRecordsPerOperand:]
+		}
+
+		value, err := json.Marshal(records)
+		if err != nil {
+			req.Logger.Error(err, "failed to marshal the drift records", "key", key)
+			continue
+		}
+		cm.Data[key] = string(value)
+	}
+
+	if cm.ResourceVersion == "" {
+		err = r.client.Create(req.Ctx, cm)
+	} else {
+		err = r.client.Update(req.Ctx, cm)
+	}
+
+	if err != nil {
+		req.Logger.Error(err, "failed to write the drift report")
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"slices"
+	"strings"
 	"time"
 
 	"github.com/blang/semver/v4"
@@ -25,6 +26,7 @@ import (
 	networkingv1 "k8s.io/api/networking/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	schedulingv1 "k8s.io/api/scheduling/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	apimetav1 "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -82,6 +84,7 @@ const (
 	commonProgressingReason     = "HCOProgressing"
 	taintedConfigurationReason  = "UnsupportedFeatureAnnotation"
 	taintedConfigurationMessage = "Unsupported feature was activated via an HCO annotation"
+	operandsFrozenReason        = "FrozenOperandsAnnotation"
 	systemHealthStatusHealthy   = "healthy"
 	systemHealthStatusWarning   = "warning"
 	systemHealthStatusError     = "error"
@@ -332,6 +335,7 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 	if instance == nil {
 		// if the HyperConverged CR was deleted during an upgrade process, then this is not an upgrade anymore
 		r.upgradeMode = false
+		reportUpgradeStatus(false, nil)
 		err = r.setOperatorUpgradeableStatus(hcoRequest)
 
 		return reconcile.Result{}, err
@@ -356,7 +360,11 @@ func (r *ReconcileHyperConverged) Reconcile(ctx context.Context, request reconci
 		return reconcile.Result{}, err
 	}
 
+	reportUpgradeStatus(r.upgradeMode, hcoRequest.Instance.Status.Upgrade)
+
+	start := time.Now()
 	requeue, err := r.updateHyperConverged(hcoRequest)
+	metrics.ObserveReconcilePhaseDuration(metrics.ReconcilePhaseStatusUpdate, time.Since(start))
 	if requeue || apierrors.IsConflict(err) {
 		result.RequeueAfter = requeueAfter
 	}
@@ -426,16 +434,22 @@ func (r *ReconcileHyperConverged) doReconcile(req *common.HcoRequest) (reconcile
 	req.SetUpgradeMode(r.upgradeMode)
 
 	if r.upgradeMode {
+		startUpgradeStatus(req, knownHcoVersion, r.ownVersion)
 		if result, err := r.handleUpgrade(req); result != nil {
 			return *result, err
 		}
+	} else {
+		// restoring the pre-upgrade spec during the upgrade itself, would just get the upgrade patches applied again
+		r.restoreUpgradeSnapshot(req)
 	}
 
 	return r.EnsureOperandAndComplete(req, init)
 }
 
 func (r *ReconcileHyperConverged) handleUpgrade(req *common.HcoRequest) (*reconcile.Result, error) {
+	start := time.Now()
 	modified, err := r.migrateBeforeUpgrade(req)
+	metrics.ObserveReconcilePhaseDuration(metrics.ReconcilePhaseUpgrade, time.Since(start))
 	if err != nil {
 		return &reconcile.Result{RequeueAfter: requeueAfter}, err
 	}
@@ -448,7 +462,13 @@ func (r *ReconcileHyperConverged) handleUpgrade(req *common.HcoRequest) (*reconc
 }
 
 func (r *ReconcileHyperConverged) EnsureOperandAndComplete(req *common.HcoRequest, init bool) (reconcile.Result, error) {
-	if err := r.operandHandler.Ensure(req); err != nil {
+	start := time.Now()
+	err := r.operandHandler.Ensure(req)
+	metrics.ObserveReconcilePhaseDuration(metrics.ReconcilePhaseOperands, time.Since(start))
+	r.updateDriftReport(req)
+	updateUpgradeBlockingOperand(req)
+
+	if err != nil {
 		r.updateConditions(req)
 		requeue := time.Duration(0)
 		if init {
@@ -514,6 +534,7 @@ func (r *ReconcileHyperConverged) getHyperConverged(req *common.HcoRequest) (*hc
 	if apierrors.IsNotFound(err) {
 		req.Logger.Info("No HyperConverged resource")
 		metrics.SetHCOMetricHyperConvergedNotExists()
+		metrics.ResetOperandConditions()
 
 		// Request object not found, could have been deleted after reconcile request.
 		// Owned objects are automatically garbage collected. For additional cleanup logic use finalizers.
@@ -882,6 +903,7 @@ func (r *ReconcileHyperConverged) completeReconciliation(req *common.HcoRequest)
 		if r.upgradeMode && req.ComponentUpgradeInProgress && !req.Dirty {
 			// update the new version only when upgrade is completed
 			UpdateVersion(&req.Instance.Status, hcoVersionName, r.ownVersion)
+			completeUpgradeStatus(req)
 			req.StatusDirty = true
 
 			r.upgradeMode = false
@@ -948,6 +970,8 @@ func (r *ReconcileHyperConverged) updateConditions(req *common.HcoRequest) {
 	// Detect a "TaintedConfiguration" state, and raise a corresponding event
 	r.detectTaintedConfiguration(req, &conditions)
 
+	r.detectFrozenOperands(req, &conditions)
+
 	if !reflect.DeepEqual(conditions, req.Instance.Status.Conditions) {
 		req.Instance.Status.Conditions = conditions
 		req.StatusDirty = true
@@ -1015,6 +1039,37 @@ func (r *ReconcileHyperConverged) detectTaintedConfiguration(req *common.HcoRequ
 	}
 }
 
+// detectFrozenOperands raises the OperandsFrozen condition if some of the operands are frozen. Like the
+// TaintedConfiguration condition, it is removed instead of being set to False.
+func (r *ReconcileHyperConverged) detectFrozenOperands(req *common.HcoRequest, conditions *[]metav1.Condition) {
+	conditionExists := apimetav1.IsStatusConditionTrue(req.Instance.Status.Conditions, hcov1beta1.ConditionOperandsFrozen)
+
+	if req.FrozenOperands == nil {
+		// the operands were not checked in this reconciliation; keep the current state, unless the annotation was removed
+		if _, ok := req.Instance.Annotations[common.FrozenOperandsAnnotationName]; ok || !conditionExists {
+			return
+		}
+	}
+
+	if len(req.FrozenOperands) > 0 {
+		apimetav1.SetStatusCondition(conditions, metav1.Condition{
+			Type:               hcov1beta1.ConditionOperandsFrozen,
+			Status:             metav1.ConditionTrue,
+			Reason:             operandsFrozenReason,
+			Message:            "The following operands are frozen, and are not reconciled: " + strings.Join(req.FrozenOperands, ", "),
+			ObservedGeneration: req.Instance.Generation,
+		})
+
+		if !conditionExists {
+			r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "OperandsFrozen",
+				"Stopped reconciling the frozen operands: "+strings.Join(req.FrozenOperands, ", "))
+		}
+	} else if conditionExists {
+		apimetav1.RemoveStatusCondition(conditions, hcov1beta1.ConditionOperandsFrozen)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "OperandsUnfrozen", "All the operands are reconciled")
+	}
+}
+
 func (r *ReconcileHyperConverged) getSystemHealthStatus(conditions common.HcoConditions) string {
 	if isSystemHealthStatusError(conditions) {
 		return systemHealthStatusError
@@ -1122,11 +1177,17 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 		return false, err
 	}
 
-	tmpInstance, err := upgradepatch.ApplyUpgradePatch(req.Logger, req.Instance, knownHcoSV)
+	if err = r.snapshotBeforeUpgrade(req, knownHcoVersion); err != nil {
+		return false, err
+	}
+
+	tmpInstance, results, err := upgradepatch.ApplyUpgradePatch(req.Logger, req.Instance, knownHcoSV, r.getClusterFacts(req))
 	if err != nil {
 		return false, err
 	}
 
+	r.setUpgradePatchesStatus(req, knownHcoVersion, results)
+
 	for _, p := range upgradepatch.GetObjectsToBeRemoved() {
 		removed, err := r.removeLeftover(req, knownHcoSV, p)
 		if err != nil {
@@ -1134,6 +1195,12 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 		}
 	}
 
+	for _, rule := range upgradepatch.GetObjectsRemovalRules() {
+		if err = r.applyRemovalRule(req, knownHcoSV, rule); err != nil {
+			return false, err
+		}
+	}
+
 	if !reflect.DeepEqual(tmpInstance.Spec, req.Instance.Spec) {
 		req.Logger.Info("updating HCO spec as a result of upgrade patches")
 		tmpInstance.Spec.DeepCopyInto(&req.Instance.Spec)
@@ -1144,6 +1211,44 @@ func (r *ReconcileHyperConverged) applyUpgradePatches(req *common.HcoRequest) (b
 	return modified, nil
 }
 
+func (r *ReconcileHyperConverged) getClusterFacts(req *common.HcoRequest) upgradepatch.ClusterFacts {
+	return upgradepatch.ClusterFacts{
+		IsOpenshift:               hcoutil.GetClusterInfo().IsOpenshift(),
+		ControlPlaneArchitectures: nodeinfo.GetControlPlaneArchitectures(),
+		WorkloadsArchitectures:    nodeinfo.GetWorkloadsArchitectures(),
+		CRDExists: func(crdName string) (bool, error) {
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			err := r.client.Get(req.Ctx, client.ObjectKey{Name: crdName}, crd)
+			if err != nil {
+				if apierrors.IsNotFound(err) {
+					return false, nil
+				}
+				return false, err
+			}
+			return true, nil
+		},
+	}
+}
+
+// setUpgradePatchesStatus records the upgrade patch outcomes, once per upgrade. Later iterations of the same upgrade
+// evaluate the patches against an already patched CR, so their outcomes do not reflect what the upgrade did.
+func (r *ReconcileHyperConverged) setUpgradePatchesStatus(req *common.HcoRequest, knownHcoVersion string, results []hcov1beta1.UpgradePatchResult) {
+	if st := req.Instance.Status.UpgradePatches; st != nil && st.SourceVersion == knownHcoVersion && st.TargetVersion == r.ownVersion {
+		return
+	}
+
+	req.Instance.Status.UpgradePatches = &hcov1beta1.UpgradePatchesStatus{
+		SourceVersion: knownHcoVersion,
+		TargetVersion: r.ownVersion,
+		Patches:       results,
+	}
+	req.StatusDirty = true
+
+	for _, result := range results {
+		metrics.IncUpgradePatches(string(result.Outcome))
+	}
+}
+
 func (r *ReconcileHyperConverged) removeLeftover(req *common.HcoRequest, knownHcoSV semver.Version, p upgradepatch.ObjectToBeRemoved) (bool, error) {
 	if p.IsAffectedRange(knownHcoSV) {
 		removeRelatedObject(req, r.client, p.GroupVersionKind, p.ObjectKey)
@@ -1164,6 +1269,75 @@ func (r *ReconcileHyperConverged) removeLeftover(req *common.HcoRequest, knownHc
 	return false, nil
 }
 
+// applyRemovalRule removes all the objects that match the rule. Objects that were not deployed by HCO are
+// protected, unless the rule explicitly includes them.
+func (r *ReconcileHyperConverged) applyRemovalRule(req *common.HcoRequest, knownHcoSV semver.Version, rule upgradepatch.ObjectsRemovalRule) error {
+	if !rule.IsAffectedRange(knownHcoSV) {
+		return nil
+	}
+
+	selector, err := rule.GetSelector()
+	if err != nil {
+		return err
+	}
+
+	namespaces := rule.Namespaces
+	if len(namespaces) == 0 {
+		namespaces = []string{metav1.NamespaceAll}
+	}
+
+	protectNonHCOObjects := !rule.IncludeNonHCOObjects
+
+	for _, ns := range namespaces {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(rule.GroupVersionKind.GroupVersion().WithKind(rule.GroupVersionKind.Kind + "List"))
+
+		err = r.client.List(req.Ctx, list, client.InNamespace(ns), client.MatchingLabelsSelector{Selector: selector})
+		if err != nil {
+			if apimetav1.IsNoMatchError(err) {
+				// the kind is not known in this cluster; nothing to remove
+				return nil
+			}
+
+			if apierrors.IsForbidden(err) {
+				// a missing permission must not block the upgrade; the leftovers are kept
+				req.Logger.Error(err, "not permitted to list the objects to be removed", "removalRule", rule, "namespace", ns)
+				return nil
+			}
+
+			req.Logger.Error(err, "failed listing objects to be removed", "removalRule", rule, "namespace", ns)
+			return err
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if !rule.MatchName(obj.GetName()) {
+				continue
+			}
+
+			if rule.DryRun {
+				_, err = hcoutil.ComponentResourceRemoval(req.Ctx, r.client, obj, req.Instance.Name, req.Logger, true, false, protectNonHCOObjects)
+				if err != nil {
+					return err
+				}
+				continue
+			}
+
+			removed, err := r.deleteObj(req, obj, protectNonHCOObjects)
+			if err != nil {
+				return err
+			}
+
+			// protected objects are not removed, so they are kept in the related objects
+			if removed {
+				removeRelatedObject(req, r.client, rule.GroupVersionKind, client.ObjectKeyFromObject(obj))
+			}
+		}
+	}
+
+	return nil
+}
+
 func (r *ReconcileHyperConverged) deleteObj(req *common.HcoRequest, obj client.Object, protectNonHCOObjects bool) (bool, error) {
 	removed, err := hcoutil.EnsureDeleted(req.Ctx, r.client, obj, req.Instance.Name, req.Logger, false, false, protectNonHCOObjects)
 
@@ -44,6 +44,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/reqresolver"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 	"github.com/kubevirt/hyperconverged-cluster-operator/version"
 )
@@ -1365,6 +1366,57 @@ var _ = Describe("HyperconvergedController", func() {
 				validateOperatorCondition(reconciler, metav1.ConditionTrue, hcoutil.UpgradeableAllowReason, hcoutil.UpgradeableAllowMessage)
 			})
 
+			It("should report the upgrade progress in the HyperConverged status", func() {
+				// old HCO Version is set
+				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
+
+				// CDI is not upgraded yet
+				expected.cdi.Status.ObservedVersion = oldComponentVersion
+
+				cl := expected.initClient()
+				foundResource, reconciler, _ := doReconcile(cl, expected.hco, nil)
+
+				upgrade := foundResource.Status.Upgrade
+				Expect(upgrade).ToNot(BeNil())
+				Expect(upgrade.SourceVersion).To(Equal(oldVersion))
+				Expect(upgrade.TargetVersion).To(Equal(newHCOVersion))
+				Expect(upgrade.StartTime.IsZero()).To(BeFalse())
+				Expect(upgrade.CompletionTime).To(BeNil())
+				Expect(upgrade.BlockingOperand).To(Equal("CDI/cdi-kubevirt-hyperconverged"))
+
+				Expect(foundResource.Status.OperandVersions).To(ContainElements(
+					hcov1beta1.OperandVersion{Name: "KubeVirt", ObservedVersion: newComponentVersion, TargetVersion: newComponentVersion},
+					hcov1beta1.OperandVersion{Name: "CDI", ObservedVersion: oldComponentVersion, TargetVersion: newComponentVersion},
+				))
+
+				Expect(metrics.IsUpgradeInProgress()).To(BeTrue())
+				blockingOperand, found, err := metrics.GetUpgradeBlockingOperand()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(blockingOperand).To(Equal("cdi/cdi-kubevirt-hyperconverged"))
+
+				// now, complete the upgrade
+				expected.cdi.Status.ObservedVersion = newComponentVersion
+				expected.hco.Status.Upgrade = upgrade.DeepCopy()
+				cl = expected.initClient()
+
+				Eventually(func(g Gomega) {
+					foundResource, reconciler, _ = doReconcile(cl, expected.hco, reconciler)
+					g.Expect(foundResource.Status.Upgrade.CompletionTime).ToNot(BeNil())
+				}).WithTimeout(time.Second).WithPolling(time.Millisecond).Should(Succeed())
+
+				Expect(foundResource.Status.Upgrade.StartTime).To(Equal(upgrade.StartTime))
+				Expect(foundResource.Status.Upgrade.BlockingOperand).To(BeEmpty())
+				Expect(foundResource.Status.OperandVersions).To(ContainElement(
+					hcov1beta1.OperandVersion{Name: "CDI", ObservedVersion: newComponentVersion, TargetVersion: newComponentVersion},
+				))
+
+				Expect(metrics.IsUpgradeInProgress()).To(BeFalse())
+				_, found, err = metrics.GetUpgradeBlockingOperand()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
 			It("don't increase the overwrittenModifications metric during upgrade", func() {
 				// old HCO Version is set
 				UpdateVersion(&expected.hco.Status, hcoVersionName, oldVersion)
@@ -1744,6 +1796,38 @@ var _ = Describe("HyperconvergedController", func() {
 					Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal(customBandwidthPerMigration)))
 				})
 
+				It("should report the upgrade patch outcomes in the HyperConverged status", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.4.99")
+					expected.hco.Spec.LiveMigrationConfig.BandwidthPerMigration = ptr.To(customBandwidthPerMigration)
+
+					appliedBefore, err := metrics.GetUpgradePatchesCount(string(hcov1beta1.UpgradePatchApplied))
+					Expect(err).ToNot(HaveOccurred())
+					upgradePhaseBefore, err := metrics.GetReconcilePhaseCount(metrics.ReconcilePhaseUpgrade)
+					Expect(err).ToNot(HaveOccurred())
+
+					cl := expected.initClient()
+					foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+
+					Expect(foundResource.Status.UpgradePatches).ToNot(BeNil())
+					Expect(foundResource.Status.UpgradePatches.SourceVersion).To(Equal("1.4.99"))
+					Expect(foundResource.Status.UpgradePatches.TargetVersion).To(Equal(newHCOVersion))
+					Expect(foundResource.Status.UpgradePatches.Patches).ToNot(BeEmpty())
+
+					Expect(foundResource.Status.UpgradePatches.Patches[0].Outcome).To(Equal(hcov1beta1.UpgradePatchTestFailed))
+					Expect(foundResource.Status.UpgradePatches.Patches[1].Outcome).To(Equal(hcov1beta1.UpgradePatchApplied))
+
+					appliedAfter, err := metrics.GetUpgradePatchesCount(string(hcov1beta1.UpgradePatchApplied))
+					Expect(err).ToNot(HaveOccurred())
+					Expect(appliedAfter).To(BeNumerically(">", appliedBefore))
+					Expect(metrics.GetReconcilePhaseCount(metrics.ReconcilePhaseUpgrade)).To(BeNumerically(">", upgradePhaseBefore))
+
+					// the outcomes are recorded once per upgrade
+					foundResource, _, _ = doReconcile(cl, expected.hco, nil)
+					Expect(foundResource.Status.UpgradePatches.Patches[1].Outcome).To(Equal(hcov1beta1.UpgradePatchApplied))
+					Expect(metrics.GetUpgradePatchesCount(string(hcov1beta1.UpgradePatchApplied))).To(Equal(appliedAfter))
+				})
+
 				It("should preserve spec.livemigrationconfig.bandwidthpermigration even if == 64Mi when upgrading from >= 1.5.1", func() {
 					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.5.1")
 					expected.hco.Spec.LiveMigrationConfig.BandwidthPerMigration = ptr.To(badBandwidthPerMigration)
@@ -1757,6 +1841,103 @@ var _ = Describe("HyperconvergedController", func() {
 				})
 			})
 
+			Context("upgrade snapshot", func() {
+				It("should snapshot the HyperConverged spec and the operand CRs before applying the upgrade patches", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.4.99")
+					expected.hco.Spec.LiveMigrationConfig.BandwidthPerMigration = ptr.To("64Mi")
+
+					cl := expected.initClient()
+					foundResource, reconciler, requeue := doReconcile(cl, expected.hco, nil)
+					Expect(requeue).To(BeTrue())
+					Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(BeNil())
+
+					cm := &corev1.ConfigMap{}
+					Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "hco-upgrade-snapshot-1.4.99"}, cm)).To(Succeed())
+					Expect(cm.Labels).To(HaveKeyWithValue(upgradeSnapshotLabel, "true"))
+					Expect(cm.Annotations).To(HaveKeyWithValue(upgradeSnapshotSourceVersionAnnotation, "1.4.99"))
+					Expect(cm.Annotations).To(HaveKeyWithValue(upgradeSnapshotTargetVersionAnnotation, newHCOVersion))
+					Expect(cm.Data).To(HaveKey(upgradeSnapshotKubeVirtKey))
+					Expect(cm.Data).To(HaveKey(upgradeSnapshotCDIKey))
+					Expect(cm.Data).To(HaveKey(upgradeSnapshotCNAOKey))
+					Expect(cm.Data).To(HaveKey(upgradeSnapshotSSPKey))
+
+					snapshotSpec := hcov1beta1.HyperConvergedSpec{}
+					Expect(json.Unmarshal([]byte(cm.Data[upgradeSnapshotHyperConvergedKey]), &snapshotSpec)).To(Succeed())
+					Expect(snapshotSpec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal("64Mi")))
+
+					// the snapshot must not be overridden by the already patched spec
+					_, _, _ = doReconcile(cl, expected.hco, reconciler)
+					Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "hco-upgrade-snapshot-1.4.99"}, cm)).To(Succeed())
+					snapshotSpec = hcov1beta1.HyperConvergedSpec{}
+					Expect(json.Unmarshal([]byte(cm.Data[upgradeSnapshotHyperConvergedKey]), &snapshotSpec)).To(Succeed())
+					Expect(snapshotSpec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal("64Mi")))
+				})
+
+				It("should only keep the last snapshots", func() {
+					UpdateVersion(&expected.hco.Status, hcoVersionName, "1.4.99")
+
+					resources := expected.toArray()
+					for i, ver := range []string{"1.4.1", "1.4.2", "1.4.3"} {
+						resources = append(resources, &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      getUpgradeSnapshotName(ver),
+								Namespace: namespace,
+								Labels:    map[string]string{upgradeSnapshotLabel: "true"},
+								Annotations: map[string]string{
+									upgradeSnapshotTimestampAnnotation: fmt.Sprintf("2020-01-0%dT00:00:00Z", i+1),
+								},
+							},
+						})
+					}
+
+					cl := commontestutils.InitClient(resources)
+					_, _, _ = doReconcile(cl, expected.hco, nil)
+
+					cms := &corev1.ConfigMapList{}
+					Expect(cl.List(context.TODO(), cms, client.MatchingLabels{upgradeSnapshotLabel: "true"})).To(Succeed())
+					names := make([]string, 0, len(cms.Items))
+					for _, cm := range cms.Items {
+						names = append(names, cm.Name)
+					}
+					Expect(names).To(ConsistOf("hco-upgrade-snapshot-1.4.2", "hco-upgrade-snapshot-1.4.3", "hco-upgrade-snapshot-1.4.99"))
+				})
+
+				It("should restore the HyperConverged spec from the snapshot, when the restore annotation is set", func() {
+					snapshotSpec := expected.hco.Spec.DeepCopy()
+					snapshotSpec.LiveMigrationConfig.BandwidthPerMigration = ptr.To("64Mi")
+					snapshotJSON, err := json.Marshal(snapshotSpec)
+					Expect(err).ToNot(HaveOccurred())
+
+					expected.hco.Annotations = map[string]string{common.RestoreUpgradeSnapshotAnnotationName: "1.4.99"}
+
+					resources := append(expected.toArray(), &corev1.ConfigMap{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "hco-upgrade-snapshot-1.4.99",
+							Namespace: namespace,
+							Labels:    map[string]string{upgradeSnapshotLabel: "true"},
+						},
+						Data: map[string]string{upgradeSnapshotHyperConvergedKey: string(snapshotJSON)},
+					})
+
+					cl := commontestutils.InitClient(resources)
+					foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+
+					Expect(foundResource.Annotations).ToNot(HaveKey(common.RestoreUpgradeSnapshotAnnotationName))
+					Expect(foundResource.Spec.LiveMigrationConfig.BandwidthPerMigration).To(HaveValue(Equal("64Mi")))
+				})
+
+				It("should drop the restore annotation if the snapshot does not exist", func() {
+					expected.hco.Annotations = map[string]string{common.RestoreUpgradeSnapshotAnnotationName: "1.4.99"}
+					origSpec := expected.hco.Spec.DeepCopy()
+
+					cl := expected.initClient()
+					foundResource, _, _ := doReconcile(cl, expected.hco, nil)
+
+					Expect(foundResource.Annotations).ToNot(HaveKey(common.RestoreUpgradeSnapshotAnnotationName))
+					Expect(foundResource.Spec).To(Equal(*origSpec))
+				})
+			})
+
 			Context("remove old quickstart guides", func() {
 				It("should drop old quickstart guide", func() {
 					const oldQSName = "old-quickstart-guide"
@@ -1842,6 +2023,95 @@ var _ = Describe("HyperconvergedController", func() {
 
 			Context("remove leftovers on upgrades", func() {
 
+				Context("objects removal rules", func() {
+					getObjectsRemovalRules := upgradepatch.GetObjectsRemovalRules
+
+					BeforeEach(func() {
+						var rules []upgradepatch.ObjectsRemovalRule
+						Expect(json.Unmarshal([]byte(testObjectsRemovalRules), &rules)).To(Succeed())
+
+						upgradepatch.GetObjectsRemovalRules = func() []upgradepatch.ObjectsRemovalRule {
+							return rules
+						}
+					})
+
+					AfterEach(func() {
+						upgradepatch.GetObjectsRemovalRules = getObjectsRemovalRules
+					})
+
+					It("should remove objects matching a removal rule label selector, in all the namespaces", func() {
+						UpdateVersion(&expected.hco.Status, hcoVersionName, "1.6.0")
+
+						newCM := func(name, ns string, labels map[string]string) *corev1.ConfigMap {
+							return &corev1.ConfigMap{
+								ObjectMeta: metav1.ObjectMeta{
+									Name:      name,
+									Namespace: ns,
+									Labels:    labels,
+								},
+							}
+						}
+
+						hcoLeftoverLabels := map[string]string{
+							hcoutil.AppLabel:                expected.hco.Name,
+							"hco.kubevirt.io/test-leftover": "true",
+						}
+
+						leftover1 := newCM("leftover-1", namespace, hcoLeftoverLabels)
+						leftover2 := newCM("leftover-2", "some-namespace", hcoLeftoverLabels)
+						nonHCOLeftover := newCM("leftover-3", "some-namespace", map[string]string{"hco.kubevirt.io/test-leftover": "true"})
+						dryRunLeftover := newCM("dry-run-leftover-1", "some-namespace", map[string]string{hcoutil.AppLabel: expected.hco.Name})
+						notLeftover := newCM("not-a-leftover", namespace, map[string]string{hcoutil.AppLabel: expected.hco.Name})
+
+						for _, cm := range []*corev1.ConfigMap{leftover1, nonHCOLeftover} {
+							expected.hco.Status.RelatedObjects = append(expected.hco.Status.RelatedObjects, corev1.ObjectReference{
+								APIVersion: "v1",
+								Kind:       "ConfigMap",
+								Name:       cm.Name,
+								Namespace:  cm.Namespace,
+							})
+						}
+
+						resources := append(expected.toArray(), leftover1, leftover2, nonHCOLeftover, dryRunLeftover, notLeftover)
+
+						cl := commontestutils.InitClient(resources)
+						foundResource, _, requeue := doReconcile(cl, expected.hco, nil)
+						Expect(requeue).To(BeTrue())
+
+						Expect(searchInRelatedObjects(foundResource.Status.RelatedObjects, "ConfigMap", leftover1.Name)).To(BeFalse())
+						Expect(searchInRelatedObjects(foundResource.Status.RelatedObjects, "ConfigMap", nonHCOLeftover.Name)).To(BeTrue())
+
+						for _, cm := range []*corev1.ConfigMap{leftover1, leftover2} {
+							err := cl.Get(context.TODO(), client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})
+							Expect(apierrors.IsNotFound(err)).To(BeTrue(), "%s should be removed", cm.Name)
+						}
+
+						for _, cm := range []*corev1.ConfigMap{nonHCOLeftover, dryRunLeftover, notLeftover} {
+							Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cm), &corev1.ConfigMap{})).To(Succeed(), "%s should not be removed", cm.Name)
+						}
+					})
+
+					It("should not apply removal rules upgrading from a version out of the rule range", func() {
+						UpdateVersion(&expected.hco.Status, hcoVersionName, "1.7.0")
+
+						leftover := &corev1.ConfigMap{
+							ObjectMeta: metav1.ObjectMeta{
+								Name:      "leftover-1",
+								Namespace: namespace,
+								Labels: map[string]string{
+									hcoutil.AppLabel:                expected.hco.Name,
+									"hco.kubevirt.io/test-leftover": "true",
+								},
+							},
+						}
+
+						cl := commontestutils.InitClient(append(expected.toArray(), leftover))
+						_, _, _ = doReconcile(cl, expected.hco, nil)
+
+						Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(leftover), &corev1.ConfigMap{})).To(Succeed())
+					})
+				})
+
 				It("should remove ConfigMap v2v-vmware upgrading from <= 1.6.0", func() {
 
 					cmToBeRemoved1 := &corev1.ConfigMap{
@@ -2785,6 +3055,149 @@ var _ = Describe("HyperconvergedController", func() {
 			})
 		})
 
+		Context("Detection of frozen operands", func() {
+			var (
+				hcoNamespace *corev1.Namespace
+				hco          *hcov1beta1.HyperConverged
+			)
+			BeforeEach(func() {
+				hcoNamespace = commontestutils.NewHcoNamespace()
+				hco = commontestutils.NewHco()
+				UpdateVersion(&hco.Status, hcoVersionName, version.Version)
+			})
+
+			It("Raises an OperandsFrozen condition, and skips the frozen operands", func() {
+				hco.Annotations = map[string]string{
+					common.FrozenOperandsAnnotationName: "CDI/cdi-kubevirt-hyperconverged",
+				}
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+
+				Expect(foundResource.Status.Conditions).To(ContainElement(commontestutils.RepresentCondition(metav1.Condition{
+					Type:    hcov1beta1.ConditionOperandsFrozen,
+					Status:  metav1.ConditionTrue,
+					Reason:  operandsFrozenReason,
+					Message: "The following operands are frozen, and are not reconciled: CDI/cdi-kubevirt-hyperconverged",
+				})))
+
+				cdi := handlers.NewCDIWithNameOnly(hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cdi), cdi)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+				kv := handlers.NewKubeVirtWithNameOnly(hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+			})
+
+			It("Removes the OperandsFrozen condition when the annotation is removed", func() {
+				hco.Status.Conditions = append(hco.Status.Conditions, metav1.Condition{
+					Type:    hcov1beta1.ConditionOperandsFrozen,
+					Status:  metav1.ConditionTrue,
+					Reason:  operandsFrozenReason,
+					Message: "The following operands are frozen, and are not reconciled: CDI/cdi-kubevirt-hyperconverged",
+				})
+
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				foundResource := &hcov1beta1.HyperConverged{}
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(hco), foundResource)).To(Succeed())
+
+				Expect(apimetav1.FindStatusCondition(foundResource.Status.Conditions, hcov1beta1.ConditionOperandsFrozen)).To(BeNil())
+
+				cdi := handlers.NewCDIWithNameOnly(hco)
+				Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cdi), cdi)).To(Succeed())
+			})
+		})
+
+		Context("Drift report", func() {
+			var (
+				hcoNamespace *corev1.Namespace
+				hco          *hcov1beta1.HyperConverged
+			)
+			BeforeEach(func() {
+				hcoNamespace = commontestutils.NewHcoNamespace()
+				hco = commontestutils.NewHco()
+				UpdateVersion(&hco.Status, hcoVersionName, version.Version)
+			})
+
+			getModifiedKubeVirt := func() *kubevirtcorev1.KubeVirt {
+				kv, err := handlers.NewKubeVirt(hco, namespace)
+				Expect(err).ToNot(HaveOccurred())
+				kv.Spec.ImagePullPolicy = corev1.PullAlways
+				return kv
+			}
+
+			getDriftRecords := func(cl client.Client, key string) []common.DriftRecord {
+				cm := &corev1.ConfigMap{}
+				Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: driftReportConfigMapName}, cm)).To(Succeed())
+				Expect(cm.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+				Expect(cm.Data).To(HaveKey(key))
+
+				var records []common.DriftRecord
+				Expect(json.Unmarshal([]byte(cm.Data[key]), &records)).To(Succeed())
+				return records
+			}
+
+			It("should record an overwritten out-of-band modification in the drift report", func() {
+				kv := getModifiedKubeVirt()
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco, kv})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), reqresolver.GetSecondaryCRRequest())
+				Expect(err).ToNot(HaveOccurred())
+
+				records := getDriftRecords(cl, "kubevirt."+kv.Name)
+				Expect(records).To(HaveLen(1))
+				Expect(records[0].Kind).To(Equal("KubeVirt"))
+				Expect(records[0].Name).To(Equal(kv.Name))
+				Expect(records[0].Changes).To(ContainElement(common.FieldDrift{
+					Path: "/spec/imagePullPolicy",
+					Op:   "remove",
+					From: string(corev1.PullAlways),
+				}))
+			})
+
+			It("should only keep the last drift records of each operand", func() {
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				kv := handlers.NewKubeVirtWithNameOnly(hco)
+				for range maxDriftRecordsPerOperand + 2 {
+					Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+					kv.Spec.ImagePullPolicy = corev1.PullAlways
+					Expect(cl.Update(context.TODO(), kv)).To(Succeed())
+
+					_, err = r.Reconcile(context.TODO(), reqresolver.GetSecondaryCRRequest())
+					Expect(err).ToNot(HaveOccurred())
+				}
+
+				Expect(getDriftRecords(cl, "kubevirt."+kv.Name)).To(HaveLen(maxDriftRecordsPerOperand))
+			})
+
+			It("should not create the drift report if nothing was overwritten", func() {
+				cl := commontestutils.InitClient([]client.Object{hcoNamespace, hco})
+				r := initReconciler(cl, nil)
+
+				_, err := r.Reconcile(context.TODO(), request)
+				Expect(err).ToNot(HaveOccurred())
+
+				cm := &corev1.ConfigMap{}
+				Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: driftReportConfigMapName}, cm)).To(MatchError(apierrors.IsNotFound, "not found error"))
+			})
+		})
+
 		Context("Detection of a tainted configuration", func() {
 			var (
 				hcoNamespace *corev1.Namespace
@@ -3569,3 +3982,18 @@ func openshift2CdiSecProfile(hcProfile *openshiftconfigv1.TLSSecurityProfile) *c
 		Custom:       custom,
 	}
 }
+
+const testObjectsRemovalRules = `[
+  {
+    "semverRange": "<1.7.0",
+    "groupVersionKind": {"group": "", "version": "v1", "kind": "ConfigMap"},
+    "labelSelector": {"matchLabels": {"hco.kubevirt.io/test-leftover": "true"}}
+  },
+  {
+    "semverRange": "<1.7.0",
+    "groupVersionKind": {"group": "", "version": "v1", "kind": "ConfigMap"},
+    "namespaces": ["some-namespace"],
+    "namePrefix": "dry-run-leftover-",
+    "dryRun": true
+  }
+]`
@@ -0,0 +1,205 @@
+package hyperconverged
+
+import (
+	"cmp"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	upgradeSnapshotNamePrefix = "hco-upgrade-snapshot-"
+	upgradeSnapshotLabel      = hcoutil.HCOAnnotationPrefix + "upgrade-snapshot"
+
+	upgradeSnapshotSourceVersionAnnotation = hcoutil.HCOAnnotationPrefix + "upgrade-snapshot-source-version"
+	upgradeSnapshotTargetVersionAnnotation = hcoutil.HCOAnnotationPrefix + "upgrade-snapshot-target-version"
+	upgradeSnapshotTimestampAnnotation     = hcoutil.HCOAnnotationPrefix + "upgrade-snapshot-timestamp"
+
+	upgradeSnapshotHyperConvergedKey = "hyperconverged.json"
+	upgradeSnapshotKubeVirtKey       = "kubevirt.json"
+	upgradeSnapshotCDIKey            = "cdi.json"
+	upgradeSnapshotCNAOKey           = "networkaddonsconfig.json"
+	upgradeSnapshotSSPKey            = "ssp.json"
+
+	// only keep the snapshots of the last few upgrades
+	maxUpgradeSnapshots = 3
+)
+
+var invalidSnapshotNameChars = regexp.MustCompile(`[^a-z0-9.-]+`)
+
+func getUpgradeSnapshotName(version string) string {
+	return upgradeSnapshotNamePrefix + strings.Trim(invalidSnapshotNameChars.ReplaceAllString(strings.ToLower(version), "-"), "-.")
+}
+
+// snapshotBeforeUpgrade stores the HyperConverged spec and the specs of the main operand CRs in a ConfigMap, before
+// the upgrade patches modify the HyperConverged CR. The snapshot is taken only once per source version, so later
+// iterations of the same upgrade won't override it with an already patched spec.
+func (r *ReconcileHyperConverged) snapshotBeforeUpgrade(req *common.HcoRequest, knownHcoVersion string) error {
+	name := getUpgradeSnapshotName(knownHcoVersion)
+
+	found := &corev1.ConfigMap{}
+	err := r.client.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: name}, found)
+	if err == nil {
+		return nil
+	} else if !apierrors.IsNotFound(err) {
+		req.Logger.Error(err, "failed to read the upgrade snapshot", "name", name)
+		return err
+	}
+
+	data, err := r.getUpgradeSnapshotData(req)
+	if err != nil {
+		return err
+	}
+
+	labels := hcoutil.GetLabels(req.Instance.Name, hcoutil.AppComponentDeployment)
+	labels[upgradeSnapshotLabel] = "true"
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: req.Namespace,
+			Labels:    labels,
+			Annotations: map[string]string{
+				upgradeSnapshotSourceVersionAnnotation: knownHcoVersion,
+				upgradeSnapshotTargetVersionAnnotation: r.ownVersion,
+				upgradeSnapshotTimestampAnnotation:     time.Now().UTC().Format(time.RFC3339),
+			},
+		},
+		Data: data,
+	}
+
+	req.Logger.Info("taking a snapshot of the HyperConverged CR before upgrading", "name", name)
+	if err = r.client.Create(req.Ctx, cm); err != nil {
+		req.Logger.Error(err, "failed to create the upgrade snapshot", "name", name)
+		return err
+	}
+
+	r.pruneUpgradeSnapshots(req)
+
+	return nil
+}
+
+func (r *ReconcileHyperConverged) getUpgradeSnapshotData(req *common.HcoRequest) (map[string]string, error) {
+	hcSpec, err := json.Marshal(req.Instance.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]string{
+		upgradeSnapshotHyperConvergedKey: string(hcSpec),
+	}
+
+	operands := map[string]client.Object{
+		upgradeSnapshotKubeVirtKey: handlers.NewKubeVirtWithNameOnly(req.Instance),
+		upgradeSnapshotCDIKey:      handlers.NewCDIWithNameOnly(req.Instance),
+		upgradeSnapshotCNAOKey:     handlers.NewNetworkAddonsWithNameOnly(req.Instance),
+		upgradeSnapshotSSPKey:      handlers.NewSSPWithNameOnly(req.Instance),
+	}
+
+	for key, obj := range operands {
+		err = r.client.Get(req.Ctx, client.ObjectKeyFromObject(obj), obj)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			req.Logger.Error(err, "failed to read an operand CR for the upgrade snapshot", "kind", fmt.Sprintf("%T", obj))
+			return nil, err
+		}
+
+		spec, err := getSpecJSON(obj)
+		if err != nil {
+			return nil, err
+		}
+		data[key] = spec
+	}
+
+	return data, nil
+}
+
+func getSpecJSON(obj client.Object) (string, error) {
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+	if err != nil {
+		return "", err
+	}
+
+	spec, err := json.Marshal(u["spec"])
+	if err != nil {
+		return "", err
+	}
+
+	return string(spec), nil
+}
+
+func (r *ReconcileHyperConverged) pruneUpgradeSnapshots(req *common.HcoRequest) {
+	snapshots := &corev1.ConfigMapList{}
+	err := r.client.List(req.Ctx, snapshots, client.InNamespace(req.Namespace), client.MatchingLabels{upgradeSnapshotLabel: "true"})
+	if err != nil {
+		req.Logger.Error(err, "failed to list the upgrade snapshots")
+		return
+	}
+
+	if len(snapshots.Items) <= maxUpgradeSnapshots {
+		return
+	}
+
+	slices.SortFunc(snapshots.Items, func(a, b corev1.ConfigMap) int {
+		return cmp.Compare(b.Annotations[upgradeSnapshotTimestampAnnotation], a.Annotations[upgradeSnapshotTimestampAnnotation])
+	})
+
+	for _, cm := range snapshots.Items[maxUpgradeSnapshots:] {
+		req.Logger.Info("removing an old upgrade snapshot", "name", cm.Name)
+		if err = r.client.Delete(req.Ctx, &cm); err != nil && !apierrors.IsNotFound(err) {
+			req.Logger.Error(err, "failed to remove an old upgrade snapshot", "name", cm.Name)
+		}
+	}
+}
+
+// restoreUpgradeSnapshot sets the HyperConverged spec back to the one stored in an upgrade snapshot, if the
+// restore annotation is set. The annotation value is the HCO version the snapshot was taken before upgrading from.
+// The operand CRs are then reconciled from the restored HyperConverged spec, as usual.
+func (r *ReconcileHyperConverged) restoreUpgradeSnapshot(req *common.HcoRequest) {
+	version, ok := req.Instance.Annotations[common.RestoreUpgradeSnapshotAnnotationName]
+	if !ok {
+		return
+	}
+
+	delete(req.Instance.Annotations, common.RestoreUpgradeSnapshotAnnotationName)
+	req.Dirty = true
+
+	name := getUpgradeSnapshotName(version)
+	cm := &corev1.ConfigMap{}
+	err := r.client.Get(req.Ctx, client.ObjectKey{Namespace: req.Namespace, Name: name}, cm)
+	if err != nil {
+		req.Logger.Error(err, "failed to read the upgrade snapshot", "name", name)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UpgradeSnapshotRestoreFailed",
+			fmt.Sprintf("can't read the upgrade snapshot %s: %v", name, err))
+		return
+	}
+
+	spec := hcov1beta1.HyperConvergedSpec{}
+	if err = json.Unmarshal([]byte(cm.Data[upgradeSnapshotHyperConvergedKey]), &spec); err != nil {
+		req.Logger.Error(err, "failed to parse the upgrade snapshot", "name", name)
+		r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, "UpgradeSnapshotRestoreFailed",
+			fmt.Sprintf("can't parse the upgrade snapshot %s: %v", name, err))
+		return
+	}
+
+	req.Logger.Info("restoring the HyperConverged spec from the upgrade snapshot", "name", name)
+	req.Instance.Spec = spec
+	r.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "UpgradeSnapshotRestored",
+		fmt.Sprintf("restored the HyperConverged spec from the upgrade snapshot %s", name))
+}
@@ -0,0 +1,67 @@
+package hyperconverged
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+// startUpgradeStatus records the start of an upgrade in the HyperConverged status. If HCO was restarted during the
+// upgrade, the upgrade status is kept as is, so the start time is the time the upgrade actually started.
+func startUpgradeStatus(req *common.HcoRequest, sourceVersion, targetVersion string) {
+	upgrade := req.Instance.Status.Upgrade
+	if upgrade != nil && upgrade.CompletionTime == nil &&
+		upgrade.SourceVersion == sourceVersion && upgrade.TargetVersion == targetVersion {
+		return
+	}
+
+	req.Instance.Status.Upgrade = &hcov1beta1.UpgradeStatus{
+		SourceVersion: sourceVersion,
+		TargetVersion: targetVersion,
+		StartTime:     metav1.Now(),
+	}
+	req.StatusDirty = true
+}
+
+// updateUpgradeBlockingOperand reflects the operand that blocks the completion of the upgrade, in the upgrade status
+func updateUpgradeBlockingOperand(req *common.HcoRequest) {
+	upgrade := req.Instance.Status.Upgrade
+	if !req.UpgradeMode || upgrade == nil || upgrade.BlockingOperand == req.UpgradeBlockingOperand {
+		return
+	}
+
+	upgrade.BlockingOperand = req.UpgradeBlockingOperand
+	req.StatusDirty = true
+}
+
+// completeUpgradeStatus records the completion of the upgrade in the HyperConverged status
+func completeUpgradeStatus(req *common.HcoRequest) {
+	upgrade := req.Instance.Status.Upgrade
+	if upgrade == nil {
+		return
+	}
+
+	upgrade.CompletionTime = ptr.To(metav1.Now())
+	upgrade.BlockingOperand = ""
+	req.StatusDirty = true
+}
+
+// reportUpgradeStatus exposes the upgrade status as metrics
+func reportUpgradeStatus(upgradeMode bool, upgrade *hcov1beta1.UpgradeStatus) {
+	if upgrade == nil {
+		metrics.SetUpgradeStatus(upgradeMode, time.Time{}, time.Time{}, "")
+		return
+	}
+
+	completionTime := time.Time{}
+	if upgrade.CompletionTime != nil {
+		completionTime = upgrade.CompletionTime.Time
+	}
+
+	metrics.SetUpgradeStatus(upgradeMode, upgrade.StartTime.Time, completionTime, upgrade.BlockingOperand)
+}
@@ -0,0 +1,272 @@
+package observability
+
+import (
+	"cmp"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"maps"
+	"net/http"
+	"os"
+	"slices"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+const (
+	// ServiceAccountTlsCertPath is the path to the service account TLS certificate
+	// used for TLS communication with the alertmanager API
+	ServiceAccountTlsCertPath = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
+
+	AlertmanagerSvcHost = "https://alertmanager-main.openshift-monitoring.svc.cluster.local:9094"
+
+	alertmanagerUsernameKey = "username"
+	alertmanagerPasswordKey = "password"
+	alertmanagerCABundleKey = "ca-bundle.crt"
+
+	// the time to wait before reconciling the silences again, if alertmanager is not available
+	alertmanagerRetryInterval = 5 * time.Minute
+)
+
+// getAlertmanagerApi returns the alertmanager API client. The client is initialized on the first call, and again
+// whenever the alertmanager configuration in the HyperConverged CR, or one of the Secrets and ConfigMaps it refers to,
+// is modified; e.g. when the credentials or the CA bundle are rotated. It returns nil if there is no alertmanager to
+// manage the silences in.
+func (r *Reconciler) getAlertmanagerApi(ctx context.Context) (*alertmanager.Api, error) {
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var amConfig *hcov1beta1.AlertmanagerConfig
+	if hc != nil && hc.Spec.Monitoring != nil {
+		amConfig = hc.Spec.Monitoring.Alertmanager
+	}
+
+	sourceVersions, err := r.getAlertmanagerSourceVersions(ctx, amConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.amApi != nil && equality.Semantic.DeepEqual(r.amConfig, amConfig) && maps.Equal(r.amSourceVersions, sourceVersions) {
+		return r.amApi, nil
+	}
+
+	amApi, err := r.newAlertmanagerApi(ctx, amConfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to initialize alertmanager api: %w", err)
+	}
+
+	r.amApi, r.amConfig, r.amSourceVersions = amApi, amConfig.DeepCopy(), sourceVersions
+	return amApi, nil
+}
+
+// getAlertmanagerSourceVersions returns the resourceVersions of the Secrets and ConfigMaps the alertmanager
+// configuration refers to, keyed by their kind and name.
+func (r *Reconciler) getAlertmanagerSourceVersions(ctx context.Context, amConfig *hcov1beta1.AlertmanagerConfig) (map[string]string, error) {
+	versions := map[string]string{}
+	if amConfig == nil {
+		return versions, nil
+	}
+
+	addVersion := func(obj client.Object, kind, name string) error {
+		if name == "" {
+			return nil
+		}
+		if err := r.reader.Get(ctx, types.NamespacedName{Name: name, Namespace: r.namespace}, obj); err != nil {
+			return fmt.Errorf("failed to read the %s %s: %w", name, kind, err)
+		}
+		versions[kind+"/"+name] = obj.GetResourceVersion()
+		return nil
+	}
+
+	if err := addVersion(&corev1.Secret{}, "Secret", amConfig.BasicAuthSecret); err != nil {
+		return nil, err
+	}
+
+	if amConfig.TLS != nil {
+		if err := addVersion(&corev1.Secret{}, "Secret", amConfig.TLS.ClientCertSecret); err != nil {
+			return nil, err
+		}
+		if err := addVersion(&corev1.ConfigMap{}, "ConfigMap", amConfig.TLS.CAConfigMap); err != nil {
+			return nil, err
+		}
+	}
+
+	return versions, nil
+}
+
+// newAlertmanagerApi builds the alertmanager API client from the alertmanager configuration. If the configuration is
+// missing, the alertmanager of the OpenShift cluster monitoring stack is used on OpenShift, and nil is returned on
+// other clusters.
+func (r *Reconciler) newAlertmanagerApi(ctx context.Context, amConfig *hcov1beta1.AlertmanagerConfig) (*alertmanager.Api, error) {
+	if amConfig == nil {
+		if !r.isOpenshift {
+			return nil, nil
+		}
+		return r.NewAlertmanagerApi()
+	}
+
+	cfg := alertmanager.Config{Host: amConfig.URL}
+
+	if amConfig.ServiceSelector != nil {
+		host, err := r.discoverAlertmanagerHost(ctx, amConfig.ServiceSelector, amConfig.TLS != nil)
+		if err != nil {
+			return nil, err
+		}
+		cfg.Host = host
+	}
+
+	if amConfig.BasicAuthSecret != "" {
+		secret, err := r.getAlertmanagerSecret(ctx, amConfig.BasicAuthSecret)
+		if err != nil {
+			return nil, err
+		}
+
+		cfg.Username = string(secret.Data[alertmanagerUsernameKey])
+		cfg.Password = string(secret.Data[alertmanagerPasswordKey])
+		if cfg.Username == "" {
+			return nil, fmt.Errorf("the %s key is missing in the %s Secret", alertmanagerUsernameKey, amConfig.BasicAuthSecret)
+		}
+	}
+
+	if amConfig.TLS != nil {
+		if err := r.setAlertmanagerTLSConfig(ctx, amConfig.TLS, &cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	return alertmanager.NewAPIFromConfig(cfg)
+}
+
+func (r *Reconciler) setAlertmanagerTLSConfig(ctx context.Context, tlsConfig *hcov1beta1.AlertmanagerTLSConfig, cfg *alertmanager.Config) error {
+	cfg.ServerName = tlsConfig.ServerName
+
+	if tlsConfig.CAConfigMap != "" {
+		cm := &corev1.ConfigMap{}
+		if err := r.reader.Get(ctx, types.NamespacedName{Name: tlsConfig.CAConfigMap, Namespace: r.namespace}, cm); err != nil {
+			return fmt.Errorf("failed to read the %s ConfigMap: %w", tlsConfig.CAConfigMap, err)
+		}
+
+		cfg.RootCAs = x509.NewCertPool()
+		if !cfg.RootCAs.AppendCertsFromPEM([]byte(cm.Data[alertmanagerCABundleKey])) {
+			return fmt.Errorf("no CA certificate was found in the %s key of the %s ConfigMap", alertmanagerCABundleKey, tlsConfig.CAConfigMap)
+		}
+	}
+
+	if tlsConfig.ClientCertSecret != "" {
+		secret, err := r.getAlertmanagerSecret(ctx, tlsConfig.ClientCertSecret)
+		if err != nil {
+			return err
+		}
+
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return fmt.Errorf("failed to load the client certificate from the %s Secret: %w", tlsConfig.ClientCertSecret, err)
+		}
+		cfg.ClientCertificate = &cert
+	}
+
+	return nil
+}
+
+func (r *Reconciler) getAlertmanagerSecret(ctx context.Context, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := r.reader.Get(ctx, types.NamespacedName{Name: name, Namespace: r.namespace}, secret); err != nil {
+		return nil, fmt.Errorf("failed to read the %s Secret: %w", name, err)
+	}
+
+	return secret, nil
+}
+
+// discoverAlertmanagerHost returns the URL of the alertmanager Service that matches the selector. If no Service
+// matches, alertmanager is considered as not available.
+func (r *Reconciler) discoverAlertmanagerHost(ctx context.Context, selector *hcov1beta1.AlertmanagerServiceSelector, useTLS bool) (string, error) {
+	services := &corev1.ServiceList{}
+	err := r.reader.List(ctx, services, client.InNamespace(selector.Namespace), client.MatchingLabels(selector.MatchLabels))
+	if err != nil {
+		return "", fmt.Errorf("failed to list the alertmanager Services: %w", err)
+	}
+
+	if len(services.Items) == 0 {
+		return "", fmt.Errorf("%w: no Service in the %s namespace matches the alertmanager service selector", alertmanager.ErrUnavailable, selector.Namespace)
+	}
+
+	svc := slices.MinFunc(services.Items, func(a, b corev1.Service) int {
+		return cmp.Compare(a.Name, b.Name)
+	})
+
+	idx := 0
+	if selector.Port != "" {
+		idx = slices.IndexFunc(svc.Spec.Ports, func(port corev1.ServicePort) bool {
+			return port.Name == selector.Port
+		})
+	}
+
+	if idx < 0 || idx >= len(svc.Spec.Ports) {
+		return "", fmt.Errorf("the alertmanager port was not found in the %s/%s Service", svc.Namespace, svc.Name)
+	}
+
+	scheme := "http"
+	if useTLS {
+		scheme = "https"
+	}
+
+	return fmt.Sprintf("%s://%s.%s.svc:%d", scheme, svc.Name, svc.Namespace, svc.Spec.Ports[idx].Port), nil
+}
+
+// handleAlertmanagerError degrades cleanly when alertmanager is not available: instead of failing the reconciliation,
+// the error is logged, and the silences are reconciled again later. The client is initialized again on the next
+// reconciliation; e.g. to discover the alertmanager Service again. The client is also dropped if alertmanager rejects
+// its credentials, so that they are read again on the next reconciliation.
+func (r *Reconciler) handleAlertmanagerError(err error, result *ctrl.Result) error {
+	if errors.Is(err, alertmanager.ErrUnauthorized) {
+		r.amApi = nil
+		return err
+	}
+
+	if !errors.Is(err, alertmanager.ErrUnavailable) {
+		return err
+	}
+
+	log.Info("Alertmanager is not available; the silences will be reconciled later", "reason", err.Error())
+	r.amApi = nil
+	result.RequeueAfter = alertmanagerRetryInterval
+
+	return nil
+}
+
+func (r *Reconciler) NewAlertmanagerApi() (*alertmanager.Api, error) {
+	httpClient, err := NewHTTPClient()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create http client: %w", err)
+	}
+
+	return alertmanager.NewAPI(*httpClient, AlertmanagerSvcHost, r.config.BearerToken), nil
+}
+
+func NewHTTPClient() (*http.Client, error) {
+	caCert, err := os.ReadFile(ServiceAccountTlsCertPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read service account TLS certificate: %w", err)
+	}
+
+	caCertPool := x509.NewCertPool()
+	caCertPool.AppendCertsFromPEM(caCert)
+
+	return &http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: caCertPool},
+		},
+	}, nil
+}
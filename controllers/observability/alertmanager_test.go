@@ -0,0 +1,231 @@
+package observability
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+var _ = Describe("Alertmanager configuration", func() {
+	var (
+		am *fakeAlertmanager
+		ts *httptest.Server
+	)
+
+	// the fake alertmanager requires basic authentication
+	BeforeEach(func() {
+		am = &fakeAlertmanager{silences: map[string]alertmanager.Silence{}}
+		ts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if username, password, ok := r.BasicAuth(); !ok || username != "hco" || password != "secret" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			am.ServeHTTP(w, r)
+		}))
+		DeferCleanup(ts.Close)
+	})
+
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		cl := commontestutils.InitClient(objs)
+		return &Reconciler{
+			Client:    cl,
+			reader:    cl,
+			namespace: commontestutils.Namespace,
+		}
+	}
+
+	newHC := func(amConfig *hcov1beta1.AlertmanagerConfig) *hcov1beta1.HyperConverged {
+		hc := commontestutils.NewHco()
+		hc.Spec.Monitoring = &hcov1beta1.MonitoringConfig{
+			Silences: []hcov1beta1.AlertSilence{{
+				Name:     "maintenance",
+				Matchers: []hcov1beta1.AlertSilenceMatcher{{Name: "alertname", Value: "KubeVirtCRModified", Operator: hcov1beta1.AlertSilenceMatchEqual}},
+				Duration: &metav1.Duration{Duration: 4 * time.Hour},
+				Comment:  "planned maintenance",
+			}},
+			Alertmanager: amConfig,
+		}
+		return hc
+	}
+
+	basicAuthSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-auth", Namespace: commontestutils.Namespace},
+		Data: map[string][]byte{
+			alertmanagerUsernameKey: []byte("hco"),
+			alertmanagerPasswordKey: []byte("secret"),
+		},
+	}
+
+	It("should not manage the silences if no alertmanager is configured, on a non-OpenShift cluster", func(ctx context.Context) {
+		r := newReconciler(newHC(nil))
+
+		amApi, err := r.getAlertmanagerApi(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(amApi).To(BeNil())
+
+		result, err := r.Reconcile(ctx, ctrl.Request{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(ctrl.Result{}))
+	})
+
+	It("should manage the silences in the configured alertmanager, with basic authentication", func(ctx context.Context) {
+		r := newReconciler(newHC(&hcov1beta1.AlertmanagerConfig{URL: ts.URL, BasicAuthSecret: basicAuthSecret.Name}), basicAuthSecret)
+
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+		Expect(am.activeSilences()).To(HaveLen(1))
+	})
+
+	It("should fail if the basic authentication Secret is missing", func(ctx context.Context) {
+		r := newReconciler(newHC(&hcov1beta1.AlertmanagerConfig{URL: ts.URL, BasicAuthSecret: basicAuthSecret.Name}))
+
+		Expect(r.reconcileSilences(ctx)).To(MatchError(ContainSubstring("failed to read the alertmanager-auth Secret")))
+	})
+
+	It("should initialize the client again, when the alertmanager configuration is modified", func(ctx context.Context) {
+		hc := newHC(&hcov1beta1.AlertmanagerConfig{URL: "http://alertmanager.monitoring.svc:9093"})
+		r := newReconciler(hc, basicAuthSecret)
+
+		first, err := r.getAlertmanagerApi(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.getAlertmanagerApi(ctx)).To(BeIdenticalTo(first))
+
+		hc.Spec.Monitoring.Alertmanager = &hcov1beta1.AlertmanagerConfig{URL: ts.URL, BasicAuthSecret: basicAuthSecret.Name}
+		Expect(r.Update(ctx, hc)).To(Succeed())
+
+		Expect(r.getAlertmanagerApi(ctx)).ToNot(BeIdenticalTo(first))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+		Expect(am.activeSilences()).To(HaveLen(1))
+	})
+
+	It("should initialize the client again, when the basic authentication Secret is rotated", func(ctx context.Context) {
+		secret := basicAuthSecret.DeepCopy()
+		secret.Data = map[string][]byte{
+			alertmanagerUsernameKey: []byte("hco"),
+			alertmanagerPasswordKey: []byte("old-secret"),
+		}
+		r := newReconciler(newHC(&hcov1beta1.AlertmanagerConfig{URL: ts.URL, BasicAuthSecret: secret.Name}), secret)
+
+		first, err := r.getAlertmanagerApi(ctx)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(r.getAlertmanagerApi(ctx)).To(BeIdenticalTo(first))
+		Expect(r.reconcileSilences(ctx)).To(MatchError(alertmanager.ErrUnauthorized))
+
+		secret.Data[alertmanagerPasswordKey] = []byte("secret")
+		Expect(r.Update(ctx, secret)).To(Succeed())
+
+		Expect(r.getAlertmanagerApi(ctx)).ToNot(BeIdenticalTo(first))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+		Expect(am.activeSilences()).To(HaveLen(1))
+	})
+
+	It("should drop the client if alertmanager rejects its credentials", func(ctx context.Context) {
+		secret := basicAuthSecret.DeepCopy()
+		secret.Data[alertmanagerPasswordKey] = []byte("wrong")
+		r := newReconciler(newHC(&hcov1beta1.AlertmanagerConfig{URL: ts.URL, BasicAuthSecret: secret.Name}), secret)
+
+		_, err := r.Reconcile(ctx, ctrl.Request{})
+		Expect(err).To(MatchError(ContainSubstring(alertmanager.ErrUnauthorized.Error())))
+		Expect(r.amApi).To(BeNil())
+	})
+
+	It("should degrade cleanly if alertmanager is not reachable", func(ctx context.Context) {
+		ts.Close()
+		r := newReconciler(newHC(&hcov1beta1.AlertmanagerConfig{URL: ts.URL, BasicAuthSecret: basicAuthSecret.Name}), basicAuthSecret)
+
+		result, err := r.Reconcile(ctx, ctrl.Request{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.RequeueAfter).To(Equal(alertmanagerRetryInterval))
+		Expect(r.amApi).To(BeNil())
+	})
+
+	It("should fail if the TLS configuration is invalid", func(ctx context.Context) {
+		caConfigMap := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-ca", Namespace: commontestutils.Namespace},
+			Data:       map[string]string{alertmanagerCABundleKey: "not a certificate"},
+		}
+		clientCertSecret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "alertmanager-client-cert", Namespace: commontestutils.Namespace},
+			Type:       corev1.SecretTypeTLS,
+			Data: map[string][]byte{
+				corev1.TLSCertKey:       []byte("not a certificate"),
+				corev1.TLSPrivateKeyKey: []byte("not a key"),
+			},
+		}
+		r := newReconciler(caConfigMap, clientCertSecret)
+
+		cfg := &alertmanager.Config{}
+		err := r.setAlertmanagerTLSConfig(ctx, &hcov1beta1.AlertmanagerTLSConfig{CAConfigMap: caConfigMap.Name}, cfg)
+		Expect(err).To(MatchError(ContainSubstring("no CA certificate was found in the ca-bundle.crt key of the alertmanager-ca ConfigMap")))
+
+		err = r.setAlertmanagerTLSConfig(ctx, &hcov1beta1.AlertmanagerTLSConfig{ClientCertSecret: clientCertSecret.Name, ServerName: "alertmanager"}, cfg)
+		Expect(err).To(MatchError(ContainSubstring("failed to load the client certificate from the alertmanager-client-cert Secret")))
+		Expect(cfg.ServerName).To(Equal("alertmanager"))
+	})
+
+	Context("service discovery", func() {
+		selector := &hcov1beta1.AlertmanagerServiceSelector{
+			Namespace:   "monitoring",
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "alertmanager"},
+			Port:        "web",
+		}
+
+		newService := func(name string, labels map[string]string) *corev1.Service {
+			return &corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "monitoring", Labels: labels},
+				Spec: corev1.ServiceSpec{
+					Ports: []corev1.ServicePort{
+						{Name: "reloader", Port: 8080},
+						{Name: "web", Port: 9093},
+					},
+				},
+			}
+		}
+
+		It("should discover the alertmanager by the labels of its Service", func(ctx context.Context) {
+			r := newReconciler(
+				newService("other", map[string]string{"app.kubernetes.io/name": "prometheus"}),
+				newService("alertmanager-main", selector.MatchLabels),
+				newService("alertmanager-secondary", selector.MatchLabels),
+			)
+
+			Expect(r.discoverAlertmanagerHost(ctx, selector, false)).To(Equal("http://alertmanager-main.monitoring.svc:9093"))
+			Expect(r.discoverAlertmanagerHost(ctx, selector, true)).To(Equal("https://alertmanager-main.monitoring.svc:9093"))
+
+			noPortSelector := selector.DeepCopy()
+			noPortSelector.Port = ""
+			Expect(r.discoverAlertmanagerHost(ctx, noPortSelector, false)).To(Equal("http://alertmanager-main.monitoring.svc:8080"))
+		})
+
+		It("should fail if the port is missing", func(ctx context.Context) {
+			r := newReconciler(newService("alertmanager-main", selector.MatchLabels))
+
+			wrongPortSelector := selector.DeepCopy()
+			wrongPortSelector.Port = "api"
+			_, err := r.discoverAlertmanagerHost(ctx, wrongPortSelector, false)
+			Expect(err).To(MatchError(ContainSubstring("the alertmanager port was not found")))
+		})
+
+		It("should degrade cleanly if no Service matches", func(ctx context.Context) {
+			r := newReconciler(
+				newHC(&hcov1beta1.AlertmanagerConfig{ServiceSelector: selector}),
+				newService("other", map[string]string{"app.kubernetes.io/name": "prometheus"}),
+			)
+
+			result, err := r.Reconcile(ctx, ctrl.Request{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.RequeueAfter).To(Equal(alertmanagerRetryInterval))
+		})
+	})
+})
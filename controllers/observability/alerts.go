@@ -9,11 +9,28 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/types"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 )
 
 func (r *Reconciler) ReconcileAlerts(ctx context.Context) error {
-	desiredPromRule, err := rules.BuildPrometheusRule(r.namespace, r.owner)
+	baseRule, err := rules.BuildPrometheusRule(r.namespace, r.owner)
+	if err != nil {
+		return fmt.Errorf("failed to build PrometheusRule: %v", err)
+	}
+
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil {
+		return err
+	}
+
+	var monitoring *hcov1beta1.MonitoringConfig
+	if hc != nil {
+		monitoring = hc.Spec.Monitoring
+	}
+
+	desiredPromRule, err := alertoverrides.Apply(baseRule, monitoring, rules.TunableAlerts())
 	if err != nil {
 		return fmt.Errorf("failed to build PrometheusRule: %v", err)
 	}
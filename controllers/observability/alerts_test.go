@@ -2,6 +2,7 @@ package observability_test
 
 import (
 	"context"
+	"time"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
@@ -11,10 +12,12 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/observability"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
@@ -42,7 +45,7 @@ var _ = Describe("Reconcile Alerts", func() {
 		mgr, err := commontestutils.NewManagerMock(&rest.Config{}, manager.Options{}, cl, logger)
 		Expect(err).ToNot(HaveOccurred())
 
-		reconciler = observability.NewReconciler(mgr, namespace, &appsv1.Deployment{})
+		reconciler = observability.NewReconciler(mgr, namespace, &appsv1.Deployment{}, true)
 	})
 
 	It("Should create new PrometheusRules", func() {
@@ -69,4 +72,36 @@ var _ = Describe("Reconcile Alerts", func() {
 
 		Expect(foundPromRules.Spec).To(Equal(promRules.Spec))
 	})
+
+	It("Should apply the alert overrides of the HyperConverged CR", func() {
+		hco := commontestutils.NewHco()
+		hco.Namespace = namespace
+		hco.Spec.Monitoring = &hcov1beta1.MonitoringConfig{
+			Alerts: []hcov1beta1.AlertOverride{
+				{Name: "HighCPUWorkload", Threshold: ptr.To("0.8"), For: &metav1.Duration{Duration: 15 * time.Minute}},
+				{Name: "HighNodeCPUFrequency", Disabled: true},
+			},
+		}
+		Expect(cl.Create(context.TODO(), hco)).To(Succeed())
+
+		Expect(reconciler.ReconcileAlerts(context.TODO())).To(Succeed())
+
+		var foundPromRules promv1.PrometheusRule
+		err := cl.Get(context.TODO(), client.ObjectKeyFromObject(promRules), &foundPromRules)
+		Expect(err).ToNot(HaveOccurred())
+
+		var alerts []promv1.Rule
+		for _, group := range foundPromRules.Spec.Groups {
+			alerts = append(alerts, group.Rules...)
+		}
+		Expect(alerts).ToNot(ContainElement(HaveField("Alert", "HighNodeCPUFrequency")))
+		Expect(alerts).To(ContainElement(And(
+			HaveField("Alert", "HighCPUWorkload"),
+			HaveField("Expr", intstr.FromString("instance:node_cpu_utilisation:rate1m >= 0.8")),
+			HaveField("For", HaveValue(Equal(promv1.Duration("15m")))),
+			HaveField("Annotations", HaveKeyWithValue("description", ContainSubstring("above 80%"))),
+			// the description must not contradict the overridden duration
+			HaveField("Annotations", HaveKeyWithValue("description", Not(ContainSubstring("minutes")))),
+		)))
+	})
 })
@@ -6,7 +6,9 @@ import (
 	"time"
 
 	appsv1 "k8s.io/api/apps/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -14,8 +16,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -29,25 +33,44 @@ var (
 type Reconciler struct {
 	client.Client
 
-	namespace string
-	config    *rest.Config
-	events    chan event.GenericEvent
-	owner     *metav1.OwnerReference
+	namespace   string
+	config      *rest.Config
+	events      chan event.GenericEvent
+	owner       *metav1.OwnerReference
+	isOpenshift bool
+	// reads the alertmanager Services, Secrets and ConfigMaps, that are not cached
+	reader client.Reader
 
 	amApi *alertmanager.Api
+	// the alertmanager configuration amApi was initialized with
+	amConfig *hcov1beta1.AlertmanagerConfig
+	// the resourceVersions of the Secrets and ConfigMaps amApi was initialized with
+	amSourceVersions map[string]string
 }
 
 func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result, error) {
 	log.Info("Reconciling Observability")
 
 	var errors []error
+	result := ctrl.Result{}
 
-	if err := r.ensurePodDisruptionBudgetAtLimitIsSilenced(); err != nil {
-		errors = append(errors, err)
+	if err := r.ensurePodDisruptionBudgetAtLimitIsSilenced(ctx); err != nil {
+		if err = r.handleAlertmanagerError(err, &result); err != nil {
+			errors = append(errors, err)
+		}
+	}
+
+	if err := r.reconcileSilences(ctx); err != nil {
+		if err = r.handleAlertmanagerError(err, &result); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
-	if err := r.ReconcileAlerts(ctx); err != nil {
-		errors = append(errors, err)
+	// the cluster alerts are based on the OpenShift cluster monitoring stack
+	if r.isOpenshift {
+		if err := r.ReconcileAlerts(ctx); err != nil {
+			errors = append(errors, err)
+		}
 	}
 
 	if len(errors) > 0 {
@@ -56,20 +79,40 @@ func (r *Reconciler) Reconcile(ctx context.Context, _ ctrl.Request) (ctrl.Result
 		return ctrl.Result{}, err
 	}
 
-	return ctrl.Result{}, nil
+	return result, nil
 }
 
-func NewReconciler(mgr ctrl.Manager, namespace string, ownerDeployment *appsv1.Deployment) *Reconciler {
+// getHyperConverged returns the HyperConverged CR, or nil if it does not exist or is being deleted
+func (r *Reconciler) getHyperConverged(ctx context.Context) (*hcov1beta1.HyperConverged, error) {
+	hc := &hcov1beta1.HyperConverged{}
+	err := r.Get(ctx, types.NamespacedName{Name: util.HyperConvergedName, Namespace: r.namespace}, hc)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read the HyperConverged CR: %w", err)
+	}
+
+	if hc.DeletionTimestamp != nil {
+		return nil, nil
+	}
+
+	return hc, nil
+}
+
+func NewReconciler(mgr ctrl.Manager, namespace string, ownerDeployment *appsv1.Deployment, isOpenshift bool) *Reconciler {
 	return &Reconciler{
-		Client:    mgr.GetClient(),
-		namespace: namespace,
-		config:    mgr.GetConfig(),
-		events:    make(chan event.GenericEvent, 1),
-		owner:     buildOwnerReference(ownerDeployment),
+		Client:      mgr.GetClient(),
+		namespace:   namespace,
+		config:      mgr.GetConfig(),
+		events:      make(chan event.GenericEvent, 1),
+		owner:       buildOwnerReference(ownerDeployment),
+		isOpenshift: isOpenshift,
+		reader:      mgr.GetAPIReader(),
 	}
 }
 
-func SetupWithManager(mgr ctrl.Manager, ownerDeployment *appsv1.Deployment) error {
+func SetupWithManager(mgr ctrl.Manager, ownerDeployment *appsv1.Deployment, isOpenshift bool) error {
 	log.Info("Setting up controller")
 
 	namespace := util.GetOperatorNamespaceFromEnv()
@@ -79,7 +122,7 @@ func SetupWithManager(mgr ctrl.Manager, ownerDeployment *appsv1.Deployment) erro
 		return fmt.Errorf("failed to setup Prometheus rules: %v", err)
 	}
 
-	r := NewReconciler(mgr, namespace, ownerDeployment)
+	r := NewReconciler(mgr, namespace, ownerDeployment, isOpenshift)
 	r.startEventLoop()
 
 	return ctrl.NewControllerManagedBy(mgr).
@@ -88,6 +131,12 @@ func SetupWithManager(mgr ctrl.Manager, ownerDeployment *appsv1.Deployment) erro
 			r.events,
 			&handler.EnqueueRequestForObject{},
 		)).
+		// apply the changes of the silences in the HyperConverged CR without waiting for the next periodic reconcile
+		WatchesRawSource(source.Kind(
+			mgr.GetCache(), client.Object(&hcov1beta1.HyperConverged{}),
+			&handler.EnqueueRequestForObject{},
+			predicate.GenerationChangedPredicate{},
+		)).
 		Complete(r)
 }
 
@@ -36,7 +36,7 @@ var _ = Describe("Observability Controller", func() {
 	})
 
 	It("Should successfully setup the controller", func() {
-		err := SetupWithManager(mgr, &appsv1.Deployment{})
+		err := SetupWithManager(mgr, &appsv1.Deployment{}, true)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(rules.ListAlerts()).To(Not(BeEmpty()))
 	})
@@ -48,7 +48,7 @@ var _ = Describe("Observability Controller", func() {
 			},
 		}
 
-		reconciler := NewReconciler(mgr, testNamespace, ownerDeployment)
+		reconciler := NewReconciler(mgr, testNamespace, ownerDeployment, true)
 		Expect(reconciler.owner.Name).To(Equal(ownerDeployment.Name))
 		Expect(reconciler.namespace).To(Equal(testNamespace))
 		Expect(reconciler.config).To(Equal(mgr.GetConfig()))
@@ -56,7 +56,7 @@ var _ = Describe("Observability Controller", func() {
 	})
 
 	It("Should receive periodic events in reconciler events channel", func() {
-		reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{})
+		reconciler := NewReconciler(mgr, testNamespace, &appsv1.Deployment{}, true)
 		reconciler.startEventLoop()
 
 		Eventually(reconciler.events).
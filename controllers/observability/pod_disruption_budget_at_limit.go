@@ -1,34 +1,20 @@
 package observability
 
 import (
-	"crypto/tls"
-	"crypto/x509"
+	"context"
 	"fmt"
-	"net/http"
-	"os"
 	"time"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
 )
 
-const (
-	// ServiceAccountTlsCertPath is the path to the service account TLS certificate
-	// used for TLS communication with the alertmanager API
-	ServiceAccountTlsCertPath = "/var/run/secrets/kubernetes.io/serviceaccount/service-ca.crt"
-
-	AlertmanagerSvcHost = "https://alertmanager-main.openshift-monitoring.svc.cluster.local:9094"
-)
-
-func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
-	if r.amApi == nil {
-		var err error
-		r.amApi, err = r.NewAlertmanagerApi()
-		if err != nil {
-			return fmt.Errorf("failed to initialize alertmanager api: %w", err)
-		}
+func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced(ctx context.Context) error {
+	amApi, err := r.getAlertmanagerApi(ctx)
+	if err != nil || amApi == nil {
+		return err
 	}
 
-	amSilences, err := r.amApi.ListSilences()
+	amSilences, err := amApi.ListSilences()
 	if err != nil {
 		return fmt.Errorf("failed to list alertmanager silences: %w", err)
 	}
@@ -58,7 +44,7 @@ func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
 		StartsAt: time.Now().Format(time.RFC3339),
 	}
 
-	if err := r.amApi.CreateSilence(silence); err != nil {
+	if err := amApi.CreateSilence(silence); err != nil {
 		return fmt.Errorf("failed to create alertmanager silence: %w", err)
 	}
 	log.Info("Silenced PodDisruptionBudgetAtLimit alerts")
@@ -66,31 +52,6 @@ func (r *Reconciler) ensurePodDisruptionBudgetAtLimitIsSilenced() error {
 	return nil
 }
 
-func (r *Reconciler) NewAlertmanagerApi() (*alertmanager.Api, error) {
-	httpClient, err := NewHTTPClient()
-	if err != nil {
-		return nil, fmt.Errorf("failed to create http client: %w", err)
-	}
-
-	return alertmanager.NewAPI(*httpClient, AlertmanagerSvcHost, r.config.BearerToken), nil
-}
-
-func NewHTTPClient() (*http.Client, error) {
-	caCert, err := os.ReadFile(ServiceAccountTlsCertPath)
-	if err != nil {
-		return nil, fmt.Errorf("failed to read service account TLS certificate: %w", err)
-	}
-
-	caCertPool := x509.NewCertPool()
-	caCertPool.AppendCertsFromPEM(caCert)
-
-	return &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{RootCAs: caCertPool},
-		},
-	}, nil
-}
-
 func FindPodDisruptionBudgetAtLimitSilence(amSilences []alertmanager.Silence) *alertmanager.Silence {
 	for _, silence := range amSilences {
 		if silence.Status.State != "active" {
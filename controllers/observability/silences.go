@@ -0,0 +1,202 @@
+package observability
+
+import (
+	"cmp"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+const (
+	// managedSilenceCreatedByPrefix is the prefix of the createdBy field of the silences that are declared in the
+	// HyperConverged CR. The name of the silence follows the prefix.
+	managedSilenceCreatedByPrefix = "hyperconverged-cluster-operator/"
+
+	defaultSilenceDuration = 24 * time.Hour
+
+	silenceStateExpired = "expired"
+)
+
+// reconcileSilences makes the Alertmanager silences match the silences declared in spec.monitoring.silences of the
+// HyperConverged CR. Missing silences are created, modified silences are updated, silences that are about to expire
+// are renewed, and silences that are no longer declared are expired.
+func (r *Reconciler) reconcileSilences(ctx context.Context) error {
+	declared, err := r.getDeclaredSilences(ctx)
+	if err != nil {
+		return err
+	}
+
+	amApi, err := r.getAlertmanagerApi(ctx)
+	if err != nil {
+		return err
+	}
+
+	if amApi == nil {
+		if len(declared) > 0 {
+			log.Info("No alertmanager is configured; the silences are not managed")
+		}
+		return nil
+	}
+
+	amSilences, err := amApi.ListSilences()
+	if err != nil {
+		return fmt.Errorf("failed to list alertmanager silences: %w", err)
+	}
+
+	managed := map[string][]alertmanager.Silence{}
+	for _, silence := range amSilences {
+		name, isManaged := strings.CutPrefix(silence.CreatedBy, managedSilenceCreatedByPrefix)
+		if isManaged && silence.Status.State != silenceStateExpired {
+			managed[name] = append(managed[name], silence)
+		}
+	}
+
+	var errs []error
+	now := time.Now()
+	for _, declaredSilence := range declared {
+		existing := managed[declaredSilence.Name]
+		delete(managed, declaredSilence.Name)
+
+		if len(existing) == 0 {
+			if err := amApi.CreateSilence(buildSilence(declaredSilence, now)); err != nil {
+				errs = append(errs, fmt.Errorf("failed to create the %s alertmanager silence: %w", declaredSilence.Name, err))
+				continue
+			}
+			log.Info("Created an alertmanager silence", "name", declaredSilence.Name)
+			continue
+		}
+
+		// there should be only one silence per name; expire the others
+		errs = append(errs, expireSilences(amApi, existing[1:])...)
+
+		if silence, changed := renewSilence(declaredSilence, existing[0], now); changed {
+			if err := amApi.UpdateSilence(silence); err != nil {
+				errs = append(errs, fmt.Errorf("failed to update the %s alertmanager silence: %w", declaredSilence.Name, err))
+				continue
+			}
+			log.Info("Updated an alertmanager silence", "name", declaredSilence.Name)
+		}
+	}
+
+	for _, silences := range managed {
+		errs = append(errs, expireSilences(amApi, silences)...)
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to reconcile alertmanager silences: %w", errors.Join(errs...))
+	}
+
+	return nil
+}
+
+func (r *Reconciler) getDeclaredSilences(ctx context.Context) ([]hcov1beta1.AlertSilence, error) {
+	hc, err := r.getHyperConverged(ctx)
+	if err != nil || hc == nil || hc.Spec.Monitoring == nil {
+		return nil, err
+	}
+
+	return hc.Spec.Monitoring.Silences, nil
+}
+
+func expireSilences(amApi *alertmanager.Api, silences []alertmanager.Silence) []error {
+	var errs []error
+	for _, silence := range silences {
+		if err := amApi.ExpireSilence(silence.ID); err != nil {
+			errs = append(errs, fmt.Errorf("failed to expire the %s alertmanager silence: %w", silence.ID, err))
+			continue
+		}
+		log.Info("Expired an alertmanager silence", "createdBy", silence.CreatedBy, "id", silence.ID)
+	}
+	return errs
+}
+
+func buildSilence(declared hcov1beta1.AlertSilence, now time.Time) alertmanager.Silence {
+	return alertmanager.Silence{
+		Comment:   declared.Comment,
+		CreatedBy: managedSilenceCreatedByPrefix + declared.Name,
+		EndsAt:    now.Add(getSilenceDuration(declared)).UTC().Format(time.RFC3339),
+		Matchers:  buildMatchers(declared.Matchers),
+		StartsAt:  now.UTC().Format(time.RFC3339),
+	}
+}
+
+// renewSilence returns the silence to update the existing silence with, and true, if the declared silence was
+// modified, or if it is about to expire. Otherwise, it returns false.
+//
+// A silence is about to expire if half of its duration has passed, or if it may expire before the next two periodic
+// reconciliations; so a silence is renewed on time even if one reconciliation is delayed or missed.
+func renewSilence(declared hcov1beta1.AlertSilence, existing alertmanager.Silence, now time.Time) (alertmanager.Silence, bool) {
+	silence := buildSilence(declared, now)
+	silence.ID = existing.ID
+
+	sameMatchers := matchersEqual(silence.Matchers, existing.Matchers)
+	if sameMatchers {
+		// keep the start time, so Alertmanager can update the silence in place
+		silence.StartsAt = existing.StartsAt
+	}
+
+	if !sameMatchers || silence.Comment != existing.Comment {
+		return silence, true
+	}
+
+	endsAt, err := time.Parse(time.RFC3339, existing.EndsAt)
+	if err != nil || endsAt.Sub(now) <= max(getSilenceDuration(declared)/2, 2*periodicity) {
+		return silence, true
+	}
+
+	return alertmanager.Silence{}, false
+}
+
+func getSilenceDuration(declared hcov1beta1.AlertSilence) time.Duration {
+	if declared.Duration == nil {
+		return defaultSilenceDuration
+	}
+	return declared.Duration.Duration
+}
+
+func buildMatchers(declared []hcov1beta1.AlertSilenceMatcher) []alertmanager.Matcher {
+	matchers := make([]alertmanager.Matcher, 0, len(declared))
+	for _, m := range declared {
+		matchers = append(matchers, alertmanager.Matcher{
+			IsEqual: m.Operator != hcov1beta1.AlertSilenceMatchNotEqual && m.Operator != hcov1beta1.AlertSilenceMatchNotRegex,
+			IsRegex: m.Operator == hcov1beta1.AlertSilenceMatchRegex || m.Operator == hcov1beta1.AlertSilenceMatchNotRegex,
+			Name:    m.Name,
+			Value:   m.Value,
+		})
+	}
+	return matchers
+}
+
+// matchersEqual compares the matchers regardless of their order
+func matchersEqual(a, b []alertmanager.Matcher) bool {
+	compareMatchers := func(m1, m2 alertmanager.Matcher) int {
+		return cmp.Or(
+			strings.Compare(m1.Name, m2.Name),
+			strings.Compare(m1.Value, m2.Value),
+			compareBool(m1.IsEqual, m2.IsEqual),
+			compareBool(m1.IsRegex, m2.IsRegex),
+		)
+	}
+
+	return slices.Equal(
+		slices.SortedFunc(slices.Values(a), compareMatchers),
+		slices.SortedFunc(slices.Values(b), compareMatchers),
+	)
+}
+
+func compareBool(b1, b2 bool) int {
+	switch {
+	case b1 == b2:
+		return 0
+	case b1:
+		return 1
+	default:
+		return -1
+	}
+}
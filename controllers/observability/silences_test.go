@@ -0,0 +1,308 @@
+package observability
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+// fakeAlertmanager is a minimal in-memory implementation of the Alertmanager silences API
+type fakeAlertmanager struct {
+	lock     sync.Mutex
+	silences map[string]alertmanager.Silence
+	nextID   int
+}
+
+func (am *fakeAlertmanager) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == "/api/v2/silences":
+		silences := make([]alertmanager.Silence, 0, len(am.silences))
+		for _, s := range am.silences {
+			silences = append(silences, s)
+		}
+		_ = json.NewEncoder(w).Encode(silences)
+
+	case r.Method == http.MethodPost && r.URL.Path == "/api/v2/silences":
+		s := alertmanager.Silence{}
+		if err := json.NewDecoder(r.Body).Decode(&s); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if s.ID == "" {
+			am.nextID++
+			s.ID = fmt.Sprintf("silence-%d", am.nextID)
+		} else if _, exists := am.silences[s.ID]; !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Status.State = "active"
+		am.silences[s.ID] = s
+
+	case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/api/v2/silence/"):
+		id := strings.TrimPrefix(r.URL.Path, "/api/v2/silence/")
+		s, exists := am.silences[id]
+		if !exists {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		s.Status.State = silenceStateExpired
+		am.silences[id] = s
+
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (am *fakeAlertmanager) add(s alertmanager.Silence) {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+	am.silences[s.ID] = s
+}
+
+func (am *fakeAlertmanager) activeSilences() []alertmanager.Silence {
+	am.lock.Lock()
+	defer am.lock.Unlock()
+
+	var silences []alertmanager.Silence
+	for _, s := range am.silences {
+		if s.Status.State != silenceStateExpired {
+			silences = append(silences, s)
+		}
+	}
+	return silences
+}
+
+var _ = Describe("Reconcile Silences", func() {
+	var (
+		am *fakeAlertmanager
+		ts *httptest.Server
+	)
+
+	BeforeEach(func() {
+		am = &fakeAlertmanager{silences: map[string]alertmanager.Silence{}}
+		ts = httptest.NewServer(am)
+		DeferCleanup(ts.Close)
+	})
+
+	newReconciler := func(objs ...client.Object) *Reconciler {
+		return &Reconciler{
+			Client:    commontestutils.InitClient(objs),
+			namespace: commontestutils.Namespace,
+			amApi:     alertmanager.NewAPI(http.Client{}, ts.URL, "token"),
+		}
+	}
+
+	newHCWithSilences := func(silences ...hcov1beta1.AlertSilence) *hcov1beta1.HyperConverged {
+		hc := commontestutils.NewHco()
+		hc.Spec.Monitoring = &hcov1beta1.MonitoringConfig{Silences: silences}
+		return hc
+	}
+
+	testSilence := func(name string) hcov1beta1.AlertSilence {
+		return hcov1beta1.AlertSilence{
+			Name: name,
+			Matchers: []hcov1beta1.AlertSilenceMatcher{
+				{Name: "alertname", Value: "KubeVirtNoAvailableNodesToRunVMs", Operator: hcov1beta1.AlertSilenceMatchEqual},
+				{Name: "namespace", Value: "test-.*", Operator: hcov1beta1.AlertSilenceMatchNotRegex},
+			},
+			Duration: &metav1.Duration{Duration: 4 * time.Hour},
+			Comment:  "planned maintenance",
+		}
+	}
+
+	It("should do nothing if the HyperConverged CR does not exist", func(ctx context.Context) {
+		r := newReconciler()
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+		Expect(am.activeSilences()).To(BeEmpty())
+	})
+
+	It("should create the declared silences", func(ctx context.Context) {
+		r := newReconciler(newHCWithSilences(testSilence("maintenance")))
+
+		before := time.Now().Add(-time.Second)
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].CreatedBy).To(Equal("hyperconverged-cluster-operator/maintenance"))
+		Expect(silences[0].Comment).To(Equal("planned maintenance"))
+		Expect(silences[0].Matchers).To(Equal([]alertmanager.Matcher{
+			{IsEqual: true, IsRegex: false, Name: "alertname", Value: "KubeVirtNoAvailableNodesToRunVMs"},
+			{IsEqual: false, IsRegex: true, Name: "namespace", Value: "test-.*"},
+		}))
+
+		endsAt, err := time.Parse(time.RFC3339, silences[0].EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt).To(BeTemporally(">=", before.Add(4*time.Hour).Truncate(time.Second)))
+		Expect(endsAt).To(BeTemporally("<=", time.Now().Add(4*time.Hour)))
+	})
+
+	It("should use the default duration if the duration is not set", func(ctx context.Context) {
+		silence := testSilence("maintenance")
+		silence.Duration = nil
+		r := newReconciler(newHCWithSilences(silence))
+
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(1))
+		endsAt, err := time.Parse(time.RFC3339, silences[0].EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt).To(BeTemporally("~", time.Now().Add(defaultSilenceDuration), time.Minute))
+	})
+
+	It("should be idempotent", func(ctx context.Context) {
+		r := newReconciler(newHCWithSilences(testSilence("maintenance"), testSilence("another")))
+
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(2))
+
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+		Expect(am.activeSilences()).To(ConsistOf(silences))
+	})
+
+	It("should renew a silence after half of its duration", func(ctx context.Context) {
+		now := time.Now()
+		existing := buildSilence(testSilence("maintenance"), now.Add(-3*time.Hour))
+		existing.ID = "existing"
+		existing.Status.State = "active"
+		am.add(existing)
+
+		r := newReconciler(newHCWithSilences(testSilence("maintenance")))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).To(Equal("existing"))
+		Expect(silences[0].StartsAt).To(Equal(existing.StartsAt))
+
+		endsAt, err := time.Parse(time.RFC3339, silences[0].EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt).To(BeTemporally("~", now.Add(4*time.Hour), time.Minute))
+	})
+
+	It("should not renew a silence before half of its duration", func(ctx context.Context) {
+		existing := buildSilence(testSilence("maintenance"), time.Now().Add(-time.Hour))
+		existing.ID = "existing"
+		existing.Status.State = "active"
+		am.add(existing)
+
+		r := newReconciler(newHCWithSilences(testSilence("maintenance")))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		Expect(am.activeSilences()).To(ConsistOf(existing))
+	})
+
+	It("should renew a short silence before the next two periodic reconciliations", func(ctx context.Context) {
+		silence := testSilence("maintenance")
+		silence.Duration = &metav1.Duration{Duration: 3 * time.Hour}
+
+		now := time.Now()
+		// half of the duration has not passed yet, but the silence may expire before the second next reconciliation
+		existing := buildSilence(silence, now.Add(-time.Hour))
+		existing.ID = "existing"
+		existing.Status.State = "active"
+		am.add(existing)
+
+		r := newReconciler(newHCWithSilences(silence))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).To(Equal("existing"))
+
+		endsAt, err := time.Parse(time.RFC3339, silences[0].EndsAt)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(endsAt).To(BeTemporally("~", now.Add(3*time.Hour), time.Minute))
+	})
+
+	It("should update a modified silence", func(ctx context.Context) {
+		existing := buildSilence(testSilence("maintenance"), time.Now())
+		existing.ID = "existing"
+		existing.Status.State = "active"
+		am.add(existing)
+
+		modified := testSilence("maintenance")
+		modified.Comment = "extended maintenance"
+		modified.Matchers = modified.Matchers[:1]
+		r := newReconciler(newHCWithSilences(modified))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(1))
+		Expect(silences[0].ID).To(Equal("existing"))
+		Expect(silences[0].Comment).To(Equal("extended maintenance"))
+		Expect(silences[0].Matchers).To(HaveLen(1))
+	})
+
+	It("should expire the silences that are no longer declared, and the duplicated silences", func(ctx context.Context) {
+		now := time.Now()
+		for _, id := range []string{"removed", "kept", "duplicate"} {
+			name := "maintenance"
+			if id == "removed" {
+				name = "removed"
+			}
+			s := buildSilence(testSilence(name), now)
+			s.ID = id
+			s.Status.State = "active"
+			am.add(s)
+		}
+
+		unmanaged := alertmanager.Silence{ID: "unmanaged", CreatedBy: "someone", Status: alertmanager.Status{State: "active"}}
+		am.add(unmanaged)
+
+		pdbSilence := alertmanager.Silence{ID: "pdb", CreatedBy: "hyperconverged-cluster-operator", Status: alertmanager.Status{State: "active"}}
+		am.add(pdbSilence)
+
+		r := newReconciler(newHCWithSilences(testSilence("maintenance")))
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		silences := am.activeSilences()
+		Expect(silences).To(HaveLen(3))
+		Expect(silences).To(ContainElements(unmanaged, pdbSilence))
+		Expect(silences).To(ContainElement(HaveField("CreatedBy", "hyperconverged-cluster-operator/maintenance")))
+	})
+
+	It("should expire all the managed silences if the HyperConverged CR does not declare any", func(ctx context.Context) {
+		s := buildSilence(testSilence("maintenance"), time.Now())
+		s.ID = "existing"
+		s.Status.State = "active"
+		am.add(s)
+
+		r := newReconciler(commontestutils.NewHco())
+		Expect(r.reconcileSilences(ctx)).To(Succeed())
+
+		Expect(am.activeSilences()).To(BeEmpty())
+	})
+
+	It("should compare the matchers regardless of their order", func() {
+		a := []alertmanager.Matcher{
+			{IsEqual: true, Name: "alertname", Value: "a"},
+			{IsRegex: true, Name: "namespace", Value: "b"},
+		}
+		b := []alertmanager.Matcher{a[1], a[0]}
+		Expect(matchersEqual(a, b)).To(BeTrue())
+
+		b[0].IsEqual = true
+		Expect(matchersEqual(a, b)).To(BeFalse())
+		Expect(matchersEqual(a, a[:1])).To(BeFalse())
+	})
+})
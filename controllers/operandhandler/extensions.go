@@ -0,0 +1,427 @@
+package operandhandler
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strings"
+
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utiljson "k8s.io/apimachinery/pkg/util/json"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const invalidExtensionReason = "InvalidExtension"
+
+// allowedExtensionKinds are the kinds of the objects that can be deployed from the extension ConfigMaps. HCO deploys
+// the objects with its own permissions, so only kinds that can't be used to gain more permissions are allowed.
+var allowedExtensionKinds = []schema.GroupKind{
+	{Group: "scheduling.k8s.io", Kind: "PriorityClass"},
+	{Group: "k8s.cni.cncf.io", Kind: "NetworkAttachmentDefinition"},
+	{Group: "monitoring.coreos.com", Kind: "PrometheusRule"},
+	{Group: "monitoring.coreos.com", Kind: "ServiceMonitor"},
+	{Group: "", Kind: "ConfigMap"},
+}
+
+// errNotExtensionObject is returned if an object in an extension ConfigMap already exists, but was not deployed from
+// this ConfigMap
+var errNotExtensionObject = errors.New("the object already exists, and was not deployed from this ConfigMap")
+
+// extensionObjectRef identifies an object deployed from an extension ConfigMap
+type extensionObjectRef struct {
+	APIVersion string `json:"apiVersion"`
+	Kind       string `json:"kind"`
+	Namespace  string `json:"namespace,omitempty"`
+	Name       string `json:"name"`
+}
+
+func newExtensionObjectRef(obj *unstructured.Unstructured) extensionObjectRef {
+	return extensionObjectRef{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func (ref extensionObjectRef) toUnstructured() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(schema.FromAPIVersionAndKind(ref.APIVersion, ref.Kind))
+	obj.SetNamespace(ref.Namespace)
+	obj.SetName(ref.Name)
+	return obj
+}
+
+// ensureExtensions deploys and reconciles the objects in the extension ConfigMaps; i.e. the ConfigMaps in the HCO
+// namespace, with the extension label. The objects are reconciled as the built-in operands; HCO overwrites any
+// out-of-band modification of the fields in the manifests, and removes the objects when they are removed from the
+// ConfigMap, or when the ConfigMap is deleted.
+func (h *OperandHandler) ensureExtensions(req *common.HcoRequest) error {
+	cms, err := h.listExtensionConfigMaps(req)
+	if err != nil {
+		return err
+	}
+
+	for i := range cms {
+		cm := &cms[i]
+		if cm.DeletionTimestamp != nil {
+			err = h.removeExtension(req, cm)
+		} else {
+			err = h.ensureExtension(req, cm)
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *OperandHandler) listExtensionConfigMaps(req *common.HcoRequest) ([]corev1.ConfigMap, error) {
+	cms := &corev1.ConfigMapList{}
+	err := h.client.List(req.Ctx, cms, client.InNamespace(req.Namespace), client.MatchingLabels{
+		hcoutil.AppLabel:          hcoutil.HyperConvergedName,
+		common.ExtensionLabelName: "true",
+	})
+	if err != nil {
+		req.Logger.Error(err, "failed to list the extension ConfigMaps")
+		return nil, err
+	}
+
+	slices.SortFunc(cms.Items, func(a, b corev1.ConfigMap) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	return cms.Items, nil
+}
+
+func (h *OperandHandler) ensureExtension(req *common.HcoRequest, cm *corev1.ConfigMap) error {
+	objs, err := h.getExtensionObjects(req, cm)
+	if err != nil {
+		h.reportInvalidExtension(req, cm, err)
+		// don't block the reconciliation of the other operands, because of a wrong manifest
+		return nil
+	}
+
+	// never adopt, and then overwrite or remove, objects that were not deployed from this ConfigMap
+	if err = h.verifyExtensionObjectsSource(req, cm, objs); err != nil {
+		if errors.Is(err, errNotExtensionObject) {
+			h.reportInvalidExtension(req, cm, err)
+			return nil
+		}
+		return err
+	}
+
+	prevRefs := getExtensionObjectRefs(req, cm)
+	refs := make([]extensionObjectRef, 0, len(objs))
+	for _, obj := range objs {
+		refs = append(refs, newExtensionObjectRef(obj))
+	}
+
+	// first, make sure the new objects are tracked, so they won't be left behind if the ConfigMap is deleted
+	addedFinalizer := controllerutil.AddFinalizer(cm, common.ExtensionFinalizerName)
+	allRefs := slices.Concat(prevRefs, slices.DeleteFunc(slices.Clone(refs), func(ref extensionObjectRef) bool {
+		return slices.Contains(prevRefs, ref)
+	}))
+	if addedFinalizer || len(allRefs) != len(prevRefs) {
+		if err = h.updateExtensionObjectRefs(req, cm, allRefs); err != nil {
+			return err
+		}
+	}
+
+	aggregateStatus := cm.Annotations[common.ExtensionAggregateStatusAnnotationName] == "true"
+	for _, obj := range objs {
+		res := ensureOperand(req, operands.NewUnstructuredHandler(h.client, h.scheme, obj, aggregateStatus))
+		if res.Err != nil {
+			h.handleFailedOperand(req, res)
+			return res.Err
+		}
+
+		h.handleEnsureResult(req, res)
+	}
+
+	for _, ref := range prevRefs {
+		if !slices.Contains(refs, ref) {
+			if err = h.removeExtensionObject(req, cm, ref); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(allRefs) != len(refs) {
+		return h.updateExtensionObjectRefs(req, cm, refs)
+	}
+
+	return nil
+}
+
+func (h *OperandHandler) reportInvalidExtension(req *common.HcoRequest, cm *corev1.ConfigMap, err error) {
+	req.Logger.Error(err, "can't read the manifests of an extension ConfigMap", "name", cm.Name)
+	h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeWarning, invalidExtensionReason,
+		fmt.Sprintf("can't read the manifests in the %s ConfigMap: %v", cm.Name, err))
+}
+
+// verifyExtensionObjectsSource checks that the objects of the extension ConfigMap that already exist, were deployed
+// from this ConfigMap
+func (h *OperandHandler) verifyExtensionObjectsSource(req *common.HcoRequest, cm *corev1.ConfigMap, objs []*unstructured.Unstructured) error {
+	for _, obj := range objs {
+		existing := &unstructured.Unstructured{}
+		existing.SetGroupVersionKind(obj.GroupVersionKind())
+		err := h.client.Get(req.Ctx, client.ObjectKeyFromObject(obj), existing)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return err
+		}
+
+		if !isExtensionObject(req, cm, existing) {
+			return fmt.Errorf("%s %s: %w", obj.GetKind(), obj.GetName(), errNotExtensionObject)
+		}
+	}
+
+	return nil
+}
+
+// isExtensionObject returns true if the object was deployed from the extension ConfigMap
+func isExtensionObject(req *common.HcoRequest, cm *corev1.ConfigMap, obj client.Object) bool {
+	labels := obj.GetLabels()
+	return labels[hcoutil.AppLabel] == req.Instance.Name && labels[common.ExtensionSourceLabelName] == cm.Name
+}
+
+// removeExtension removes all the objects deployed from a deleted extension ConfigMap, and then releases the
+// ConfigMap finalizer
+func (h *OperandHandler) removeExtension(req *common.HcoRequest, cm *corev1.ConfigMap) error {
+	if !controllerutil.ContainsFinalizer(cm, common.ExtensionFinalizerName) {
+		return nil
+	}
+
+	for _, ref := range getExtensionObjectRefs(req, cm) {
+		if err := h.removeExtensionObject(req, cm, ref); err != nil {
+			return err
+		}
+	}
+
+	controllerutil.RemoveFinalizer(cm, common.ExtensionFinalizerName)
+	if err := h.client.Update(req.Ctx, cm); err != nil {
+		req.Logger.Error(err, "failed to remove the finalizer of an extension ConfigMap", "name", cm.Name)
+		return err
+	}
+
+	return nil
+}
+
+// removeExtensions removes the objects of all the extension ConfigMaps, when the HyperConverged CR is deleted
+func (h *OperandHandler) removeExtensions(req *common.HcoRequest) error {
+	cms, err := h.listExtensionConfigMaps(req)
+	if err != nil {
+		return err
+	}
+
+	for i := range cms {
+		if err = h.removeExtension(req, &cms[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (h *OperandHandler) removeExtensionObject(req *common.HcoRequest, cm *corev1.ConfigMap, ref extensionObjectRef) error {
+	obj := ref.toUnstructured()
+
+	// the objects annotation can be modified by the ConfigMap owner; only remove objects that were deployed from
+	// this ConfigMap
+	if !slices.Contains(allowedExtensionKinds, obj.GroupVersionKind().GroupKind()) {
+		req.Logger.Info("not removing an extension object of a kind that is not allowed", "kind", ref.Kind, "name", ref.Name)
+		return nil
+	}
+
+	err := h.client.Get(req.Ctx, client.ObjectKeyFromObject(obj), obj)
+	if err != nil && !apierrors.IsNotFound(err) && !meta.IsNoMatchError(err) {
+		req.Logger.Error(err, "failed to read an extension object", "kind", ref.Kind, "name", ref.Name)
+		return err
+	}
+
+	if err == nil && !isExtensionObject(req, cm, obj) {
+		req.Logger.Info("not removing an object that was not deployed from the extension ConfigMap", "kind", ref.Kind, "name", ref.Name, "configMap", cm.Name)
+		return nil
+	}
+
+	obj = ref.toUnstructured()
+	deleted, err := hcoutil.EnsureDeleted(req.Ctx, h.client, obj, req.Instance.Name, req.Logger, false, false, true)
+	if err != nil {
+		req.Logger.Error(err, "failed to remove an extension object", "kind", ref.Kind, "name", ref.Name)
+		return err
+	}
+
+	if deleted {
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", ref.Kind, ref.Name))
+	}
+
+	objRef := corev1.ObjectReference{APIVersion: ref.APIVersion, Kind: ref.Kind, Namespace: ref.Namespace, Name: ref.Name}
+	if found, _ := objectreferencesv1.FindObjectReference(req.Instance.Status.RelatedObjects, objRef); found != nil {
+		if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, objRef); err != nil {
+			return err
+		}
+		req.StatusDirty = true
+	}
+
+	return nil
+}
+
+func getExtensionObjectRefs(req *common.HcoRequest, cm *corev1.ConfigMap) []extensionObjectRef {
+	value, ok := cm.Annotations[common.ExtensionObjectsAnnotationName]
+	if !ok {
+		return nil
+	}
+
+	var refs []extensionObjectRef
+	if err := json.Unmarshal([]byte(value), &refs); err != nil {
+		req.Logger.Error(err, "can't read the objects annotation of an extension ConfigMap; ignoring it", "name", cm.Name)
+		return nil
+	}
+
+	return refs
+}
+
+func (h *OperandHandler) updateExtensionObjectRefs(req *common.HcoRequest, cm *corev1.ConfigMap, refs []extensionObjectRef) error {
+	value, err := json.Marshal(refs)
+	if err != nil {
+		return err
+	}
+
+	if cm.Annotations == nil {
+		cm.Annotations = make(map[string]string)
+	}
+	cm.Annotations[common.ExtensionObjectsAnnotationName] = string(value)
+
+	if err = h.client.Update(req.Ctx, cm); err != nil {
+		req.Logger.Error(err, "failed to update an extension ConfigMap", "name", cm.Name)
+		return err
+	}
+
+	return nil
+}
+
+// getExtensionObjects reads the manifests in an extension ConfigMap. Each value in the ConfigMap may contain several
+// YAML or JSON manifests. Namespaced objects without a namespace are deployed in the HCO namespace; other namespaces
+// must be allowed by the extension namespaces annotation of the HyperConverged CR.
+func (h *OperandHandler) getExtensionObjects(req *common.HcoRequest, cm *corev1.ConfigMap) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		keyObjs, err := parseManifests(cm.Data[key])
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", key, err)
+		}
+
+		for _, obj := range keyObjs {
+			if err = h.prepareExtensionObject(req, cm, obj); err != nil {
+				return nil, fmt.Errorf("%s: %s %s: %w", key, obj.GetKind(), obj.GetName(), err)
+			}
+
+			ref := newExtensionObjectRef(obj)
+			if slices.ContainsFunc(objs, func(o *unstructured.Unstructured) bool { return newExtensionObjectRef(o) == ref }) {
+				return nil, fmt.Errorf("%s: duplicate object %s %s", key, ref.Kind, ref.Name)
+			}
+
+			objs = append(objs, obj)
+		}
+	}
+
+	return objs, nil
+}
+
+func parseManifests(data string) ([]*unstructured.Unstructured, error) {
+	var objs []*unstructured.Unstructured
+
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewBufferString(data), 4096)
+	for {
+		raw := json.RawMessage{}
+		if err := decoder.Decode(&raw); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objs, nil
+			}
+			return nil, err
+		}
+
+		obj := &unstructured.Unstructured{}
+		// keep the integers as int64, as the API server returns them
+		if err := utiljson.Unmarshal(raw, &obj.Object); err != nil {
+			return nil, err
+		}
+
+		if len(obj.Object) == 0 { // empty YAML document
+			continue
+		}
+
+		objs = append(objs, obj)
+	}
+}
+
+func (h *OperandHandler) prepareExtensionObject(req *common.HcoRequest, cm *corev1.ConfigMap, obj *unstructured.Unstructured) error {
+	if obj.GetAPIVersion() == "" || obj.GetKind() == "" {
+		return errors.New("missing apiVersion or kind")
+	}
+
+	if obj.GetName() == "" {
+		return errors.New("missing name")
+	}
+
+	if gk := obj.GroupVersionKind().GroupKind(); !slices.Contains(allowedExtensionKinds, gk) {
+		return fmt.Errorf("the %s kind is not allowed in an extension", gk.String())
+	}
+
+	namespaced, err := h.client.IsObjectNamespaced(obj)
+	if err != nil {
+		return err
+	}
+
+	if !namespaced {
+		obj.SetNamespace("")
+	} else if obj.GetNamespace() == "" {
+		obj.SetNamespace(req.Namespace)
+	} else if ns := obj.GetNamespace(); ns != req.Namespace && !slices.Contains(getExtensionNamespaces(req), ns) {
+		return fmt.Errorf("the %s namespace is not allowed; add it to the %s annotation of the HyperConverged CR", ns, common.ExtensionNamespacesAnnotationName)
+	}
+
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	maps.Copy(labels, hcoutil.GetLabels(req.Instance.Name, hcoutil.AppComponentDeployment))
+	labels[common.ExtensionSourceLabelName] = cm.Name
+	obj.SetLabels(labels)
+
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	return nil
+}
+
+// getExtensionNamespaces returns the namespaces that the extension ConfigMaps may deploy objects in, in addition to
+// the HCO namespace
+func getExtensionNamespaces(req *common.HcoRequest) []string {
+	var namespaces []string
+	for ns := range strings.SplitSeq(req.Instance.Annotations[common.ExtensionNamespacesAnnotationName], ",") {
+		if ns = strings.TrimSpace(ns); ns != "" {
+			namespaces = append(namespaces, ns)
+		}
+	}
+	return namespaces
+}
@@ -0,0 +1,404 @@
+package operandhandler
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	conditionsv1 "github.com/openshift/custom-resource-status/conditions/v1"
+	corev1 "k8s.io/api/core/v1"
+	schedulingv1 "k8s.io/api/scheduling/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Test extension ConfigMaps", func() {
+	const (
+		extensionName = "site-extension"
+
+		manifests = `
+apiVersion: scheduling.k8s.io/v1
+kind: PriorityClass
+metadata:
+  name: site-priority
+value: 1000
+description: site specific priority class
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: site-config
+  labels:
+    site: main
+data:
+  key: value
+`
+	)
+
+	var (
+		hco          *hcov1beta1.HyperConverged
+		req          *common.HcoRequest
+		eventEmitter *commontestutils.EventEmitterMock
+	)
+
+	newExtensionCM := func(data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      extensionName,
+				Namespace: commontestutils.Namespace,
+				Labels: map[string]string{
+					hcoutil.AppLabel:          hcoutil.HyperConvergedName,
+					common.ExtensionLabelName: "true",
+				},
+			},
+			Data: map[string]string{"objects.yaml": data},
+		}
+	}
+
+	// the default RESTMapper of the fake client does not know any kind
+	initClient := func(objs ...client.Object) client.Client {
+		mapper := meta.NewDefaultRESTMapper(nil)
+		mapper.Add(corev1.SchemeGroupVersion.WithKind("ConfigMap"), meta.RESTScopeNamespace)
+		mapper.Add(hcov1beta1.SchemeGroupVersion.WithKind("HyperConverged"), meta.RESTScopeNamespace)
+		mapper.Add(schedulingv1.SchemeGroupVersion.WithKind("PriorityClass"), meta.RESTScopeRoot)
+		mapper.Add(cdiv1beta1.SchemeGroupVersion.WithKind("CDI"), meta.RESTScopeRoot)
+
+		return fake.NewClientBuilder().
+			WithScheme(commontestutils.GetScheme()).
+			WithRESTMapper(mapper).
+			WithObjects(objs...).
+			WithStatusSubresource(objs...).
+			Build()
+	}
+
+	newHandler := func(cl client.Client) *OperandHandler {
+		return &OperandHandler{
+			client:       cl,
+			scheme:       commontestutils.GetScheme(),
+			eventEmitter: eventEmitter,
+		}
+	}
+
+	getObjectRefs := func(cl client.Client) []extensionObjectRef {
+		cm := &corev1.ConfigMap{}
+		ExpectWithOffset(1, cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: extensionName}, cm)).To(Succeed())
+		ExpectWithOffset(1, cm.Finalizers).To(ContainElement(common.ExtensionFinalizerName))
+
+		var refs []extensionObjectRef
+		ExpectWithOffset(1, json.Unmarshal([]byte(cm.Annotations[common.ExtensionObjectsAnnotationName]), &refs)).To(Succeed())
+		return refs
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+		eventEmitter = commontestutils.NewEventEmitterMock()
+	})
+
+	It("should deploy the objects in the extension ConfigMap", func() {
+		cl := initClient(hco, newExtensionCM(manifests))
+		handler := newHandler(cl)
+
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(Succeed())
+		Expect(pc.Value).To(Equal(int32(1000)))
+		Expect(pc.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+		Expect(pc.Labels).To(HaveKeyWithValue(common.ExtensionSourceLabelName, extensionName))
+		Expect(pc.OwnerReferences).To(BeEmpty())
+
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: "site-config"}, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("key", "value"))
+		Expect(cm.Labels).To(HaveKeyWithValue("site", "main"))
+		Expect(cm.Labels).To(HaveKeyWithValue(hcoutil.AppLabel, hco.Name))
+		Expect(metav1.IsControlledBy(cm, hco)).To(BeTrue())
+
+		Expect(getObjectRefs(cl)).To(ConsistOf(
+			extensionObjectRef{APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass", Name: "site-priority"},
+			extensionObjectRef{APIVersion: "v1", Kind: "ConfigMap", Namespace: commontestutils.Namespace, Name: "site-config"},
+		))
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeNormal, Reason: "Created", Msg: "Created PriorityClass site-priority"},
+			{EventType: corev1.EventTypeNormal, Reason: "Created", Msg: "Created ConfigMap site-config"},
+		})).To(BeTrue())
+
+		// the next iterations should not modify the objects
+		Expect(handler.Ensure(req)).To(Succeed())
+		eventEmitter.Reset()
+		Expect(handler.Ensure(req)).To(Succeed())
+		Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+	})
+
+	It("should overwrite out-of-band modifications of the fields in the manifests, and keep the other fields", func() {
+		cl := initClient(hco, newExtensionCM(manifests))
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: "site-config"}, cm)).To(Succeed())
+		cm.Data["key"] = "modified"
+		cm.Data["other"] = "value"
+		Expect(cl.Update(context.TODO(), cm)).To(Succeed())
+
+		req = commontestutils.NewReq(hco)
+		req.HCOTriggered = false
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(cm), cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("key", "value"))
+		Expect(cm.Data).To(HaveKeyWithValue("other", "value"))
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeWarning, Reason: "Overwritten", Msg: "Overwritten ConfigMap site-config"},
+		})).To(BeTrue())
+	})
+
+	It("should remove objects that were removed from the extension ConfigMap", func() {
+		cl := initClient(hco, newExtensionCM(manifests))
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		extension := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: extensionName}, extension)).To(Succeed())
+		extension.Data["objects.yaml"] = `
+apiVersion: scheduling.k8s.io/v1
+kind: PriorityClass
+metadata:
+  name: site-priority
+value: 1000
+`
+		Expect(cl.Update(context.TODO(), extension)).To(Succeed())
+
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: "site-config"}, cm)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(Succeed())
+
+		Expect(getObjectRefs(cl)).To(ConsistOf(
+			extensionObjectRef{APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass", Name: "site-priority"},
+		))
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeNormal, Reason: "Killing", Msg: "Removed ConfigMap site-config"},
+		})).To(BeTrue())
+	})
+
+	It("should remove the objects when the extension ConfigMap is deleted", func() {
+		cl := initClient(hco, newExtensionCM(manifests))
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		extension := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: extensionName}, extension)).To(Succeed())
+		Expect(cl.Delete(context.TODO(), extension)).To(Succeed())
+
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(MatchError(apierrors.IsNotFound, "not found error"))
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: "site-config"}, cm)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+		// the finalizer was released
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(extension), extension)).To(MatchError(apierrors.IsNotFound, "not found error"))
+	})
+
+	It("should remove the objects when the HyperConverged CR is deleted", func() {
+		cl := initClient(hco, newExtensionCM(manifests))
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(handler.EnsureDeleted(req)).To(Succeed())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+		extension := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: extensionName}, extension)).To(Succeed())
+		Expect(extension.Finalizers).ToNot(ContainElement(common.ExtensionFinalizerName))
+	})
+
+	It("should not remove objects that are not labeled as HCO objects", func() {
+		cl := initClient(hco, newExtensionCM(manifests))
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(Succeed())
+		delete(pc.Labels, hcoutil.AppLabel)
+		Expect(cl.Update(context.TODO(), pc)).To(Succeed())
+
+		Expect(handler.removeExtensions(req)).To(Succeed())
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(pc), pc)).To(Succeed())
+	})
+
+	It("should deploy namespaced objects in the namespaces allowed by the HyperConverged CR", func() {
+		extension := newExtensionCM(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: site-config
+  namespace: site-namespace
+data:
+  key: value
+`)
+		hco.Annotations = map[string]string{common.ExtensionNamespacesAnnotationName: "other-namespace, site-namespace"}
+		req = commontestutils.NewReq(hco)
+
+		cl := initClient(hco, extension)
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: "site-namespace", Name: "site-config"}, cm)).To(Succeed())
+		Expect(cm.Data).To(HaveKeyWithValue("key", "value"))
+	})
+
+	It("should not adopt an existing object that was not deployed from the extension ConfigMap", func() {
+		existing := &schedulingv1.PriorityClass{
+			ObjectMeta: metav1.ObjectMeta{Name: "site-priority"},
+			Value:      5,
+		}
+
+		cl := initClient(hco, newExtensionCM(manifests), existing)
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeWarning, Reason: invalidExtensionReason, Msg: "can't read the manifests in the site-extension ConfigMap: PriorityClass site-priority: " + errNotExtensionObject.Error()},
+		})).To(BeTrue())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(Succeed())
+		Expect(pc.Value).To(Equal(int32(5)))
+		Expect(pc.Labels).ToNot(HaveKey(common.ExtensionSourceLabelName))
+
+		cm := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: "site-config"}, cm)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+		extension := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: extensionName}, extension)).To(Succeed())
+		Expect(extension.Finalizers).To(BeEmpty())
+	})
+
+	It("should not remove objects that were not deployed from the extension ConfigMap", func() {
+		foreign := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "foreign",
+				Namespace: commontestutils.Namespace,
+				Labels:    map[string]string{hcoutil.AppLabel: hco.Name},
+			},
+		}
+
+		extension := newExtensionCM(manifests)
+		extension.Finalizers = []string{common.ExtensionFinalizerName}
+		extension.Annotations = map[string]string{
+			common.ExtensionObjectsAnnotationName: `[{"apiVersion":"v1","kind":"ConfigMap","namespace":"` + commontestutils.Namespace + `","name":"foreign"}]`,
+		}
+
+		cl := initClient(hco, extension, foreign)
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(foreign), foreign)).To(Succeed())
+		Expect(getObjectRefs(cl)).To(ConsistOf(
+			extensionObjectRef{APIVersion: "scheduling.k8s.io/v1", Kind: "PriorityClass", Name: "site-priority"},
+			extensionObjectRef{APIVersion: "v1", Kind: "ConfigMap", Namespace: commontestutils.Namespace, Name: "site-config"},
+		))
+	})
+
+	DescribeTable("should report invalid manifests, and not block the reconciliation", func(data, expectedMsg string) {
+		cl := initClient(hco, newExtensionCM(data))
+		handler := newHandler(cl)
+
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeWarning, Reason: invalidExtensionReason, Msg: "can't read the manifests in the site-extension ConfigMap: objects.yaml: " + expectedMsg},
+		})).To(BeTrue())
+
+		extension := &corev1.ConfigMap{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Namespace: commontestutils.Namespace, Name: extensionName}, extension)).To(Succeed())
+		Expect(extension.Finalizers).To(BeEmpty())
+	},
+		Entry("missing name", "apiVersion: v1\nkind: ConfigMap\n", "ConfigMap : missing name"),
+		Entry("missing kind", "apiVersion: v1\nmetadata:\n  name: cm\n", " cm: missing apiVersion or kind"),
+		Entry("not allowed kind", "apiVersion: rbac.authorization.k8s.io/v1\nkind: ClusterRoleBinding\nmetadata:\n  name: crb\n", "ClusterRoleBinding crb: the ClusterRoleBinding.rbac.authorization.k8s.io kind is not allowed in an extension"),
+		Entry("not allowed namespace", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n  namespace: kube-system\n", "ConfigMap cm: the kube-system namespace is not allowed; add it to the "+common.ExtensionNamespacesAnnotationName+" annotation of the HyperConverged CR"),
+		Entry("duplicate object", "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n---\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: cm\n", "duplicate object ConfigMap cm"),
+	)
+
+	It("should ignore ConfigMaps without the extension label", func() {
+		extension := newExtensionCM(manifests)
+		delete(extension.Labels, common.ExtensionLabelName)
+
+		cl := initClient(hco, extension)
+		handler := newHandler(cl)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		pc := &schedulingv1.PriorityClass{}
+		Expect(cl.Get(context.TODO(), client.ObjectKey{Name: "site-priority"}, pc)).To(MatchError(apierrors.IsNotFound, "not found error"))
+	})
+
+	It("should aggregate the conditions of the objects, if requested", func() {
+		extension := newExtensionCM(`
+apiVersion: cdi.kubevirt.io/v1beta1
+kind: CDI
+metadata:
+  name: site-cdi
+`)
+		extension.Annotations = map[string]string{common.ExtensionAggregateStatusAnnotationName: "true"}
+
+		origAllowedKinds := allowedExtensionKinds
+		allowedExtensionKinds = append(slices.Clone(origAllowedKinds), cdiv1beta1.SchemeGroupVersion.WithKind("CDI").GroupKind())
+		DeferCleanup(func() {
+			allowedExtensionKinds = origAllowedKinds
+		})
+
+		cdi := &cdiv1beta1.CDI{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "site-cdi",
+				Labels: map[string]string{
+					hcoutil.AppLabel:                hco.Name,
+					common.ExtensionSourceLabelName: extensionName,
+				},
+			},
+		}
+		cdi.Status.Conditions = []conditionsv1.Condition{
+			{Type: conditionsv1.ConditionAvailable, Status: corev1.ConditionTrue, Reason: "Available", LastTransitionTime: metav1.Now()},
+			{Type: conditionsv1.ConditionProgressing, Status: corev1.ConditionFalse, Reason: "Done", LastTransitionTime: metav1.Now()},
+			{Type: conditionsv1.ConditionDegraded, Status: corev1.ConditionTrue, Reason: "Failed", Message: "something is wrong", LastTransitionTime: metav1.Now()},
+		}
+		cl := initClient(hco, extension, cdi)
+		handler := newHandler(cl)
+
+		// the first iteration only updates the object
+		Expect(handler.Ensure(req)).To(Succeed())
+		req = commontestutils.NewReq(hco)
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionDegraded)
+		Expect(found).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionTrue))
+		Expect(cond.Reason).To(Equal("CDIDegraded"))
+		Expect(cond.Message).To(Equal("CDI is degraded: something is wrong"))
+	})
+})
@@ -0,0 +1,62 @@
+package operandhandler
+
+import (
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+// getFrozenOperandKeys parses the frozen operands annotation, and logs the wrong items
+func getFrozenOperandKeys(req *common.HcoRequest) []common.FrozenOperandKey {
+	annotation, ok := req.Instance.Annotations[common.FrozenOperandsAnnotationName]
+	if !ok {
+		return nil
+	}
+
+	keys, wrongItems := common.ParseFrozenOperands(annotation)
+	for _, item := range wrongItems {
+		req.Logger.Info("ignoring a wrong item in the frozen operands annotation; the expected format is <kind>/<name>", "item", item)
+	}
+
+	return keys
+}
+
+// getFrozenOperands returns a slice with the <kind>/<name> of each frozen operand, in the operand's index, or an empty
+// string if the operand is not frozen. It also lists the frozen operands in the request, and in the frozen operands
+// metric.
+func getFrozenOperands(req *common.HcoRequest, allOperands []operands.Operand) []string {
+	metrics.ResetFrozenOperands()
+	req.FrozenOperands = []string{}
+
+	keys := getFrozenOperandKeys(req)
+	if len(keys) == 0 {
+		return nil
+	}
+
+	frozen := make([]string, len(allOperands))
+	for i, handler := range allOperands {
+		gh, ok := handler.(operands.CRGetter)
+		if !ok {
+			continue
+		}
+
+		cr, err := gh.GetFullCr(req.Instance)
+		if err != nil {
+			// the error will be reported when trying to reconcile this operand
+			continue
+		}
+
+		kind := operands.NewEnsureResult(cr).Type
+		key := common.NewFrozenOperandKey(kind, cr.GetName())
+		for _, k := range keys {
+			if k == key {
+				frozen[i] = kind + "/" + key.Name
+				req.FrozenOperands = append(req.FrozenOperands, frozen[i])
+				metrics.SetOperandFrozen(kind, key.Name)
+				break
+			}
+		}
+	}
+
+	return frozen
+}
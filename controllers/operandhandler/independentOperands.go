@@ -0,0 +1,127 @@
+package operandhandler
+
+import (
+	"maps"
+	"reflect"
+	"slices"
+
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+)
+
+// maxConcurrentOperands limits the number of the independent operands that are reconciled at the same time
+const maxConcurrentOperands = 8
+
+// ensureIndependentOperands reconciles the independent operands concurrently. Each operand works on its own copy of
+// the request, so the operands don't interfere with each other. The copies are then merged back into the request in
+// the operands order, so the result does not depend on the order the operands were completed in.
+func (h *OperandHandler) ensureIndependentOperands(req *common.HcoRequest, frozen []string) error {
+	if len(h.independentOperands) == 0 {
+		return nil
+	}
+
+	baseRelatedObjects := slices.Clone(req.Instance.Status.RelatedObjects)
+
+	opReqs := make([]*common.HcoRequest, len(h.independentOperands))
+	results := make([]*operands.EnsureResult, len(h.independentOperands))
+
+	eg := errgroup.Group{}
+	eg.SetLimit(maxConcurrentOperands)
+
+	for i, handler := range h.independentOperands {
+		if isFrozen(req, frozen, len(h.operands)+i) {
+			continue
+		}
+
+		opReqs[i] = newOperandRequest(req)
+		eg.Go(func() error {
+			results[i] = ensureOperand(opReqs[i], handler)
+			return nil
+		})
+	}
+
+	_ = eg.Wait()
+
+	var firstErr error
+	for i, res := range results {
+		if res == nil {
+			continue
+		}
+
+		if err := mergeOperandRequest(req, opReqs[i], baseRelatedObjects); err != nil && firstErr == nil {
+			firstErr = err
+		}
+
+		if res.Err != nil {
+			if firstErr == nil {
+				h.handleFailedOperand(req, res)
+				firstErr = res.Err
+			} else {
+				req.Logger.Error(res.Err, "failed to Ensure an operand")
+			}
+			continue
+		}
+
+		h.handleEnsureResult(req, res)
+	}
+
+	return firstErr
+}
+
+// newOperandRequest creates a copy of the request, for a single independent operand
+func newOperandRequest(req *common.HcoRequest) *common.HcoRequest {
+	opReq := *req
+	opReq.Instance = req.Instance.DeepCopy()
+	opReq.Conditions = common.NewHcoConditions()
+	opReq.Dirty = false
+	opReq.StatusDirty = false
+	opReq.Upgradeable = true
+	opReq.DriftRecords = nil
+
+	return &opReq
+}
+
+// mergeOperandRequest merges the changes an independent operand did in its copy of the request, back into the
+// request. The independent operands may only change the conditions, the drift records and the related objects list.
+func mergeOperandRequest(req, opReq *common.HcoRequest, baseRelatedObjects []corev1.ObjectReference) error {
+	for _, condType := range slices.Sorted(maps.Keys(opReq.Conditions)) {
+		req.Conditions.SetStatusCondition(opReq.Conditions[condType])
+	}
+
+	req.Dirty = req.Dirty || opReq.Dirty
+	req.StatusDirty = req.StatusDirty || opReq.StatusDirty
+	req.Upgradeable = req.Upgradeable && opReq.Upgradeable
+	req.DriftRecords = append(req.DriftRecords, opReq.DriftRecords...)
+
+	for _, ref := range opReq.Instance.Status.RelatedObjects {
+		existing, err := objectreferencesv1.FindObjectReference(baseRelatedObjects, ref)
+		if err != nil {
+			return err
+		}
+
+		if existing == nil || !reflect.DeepEqual(*existing, ref) {
+			if err = objectreferencesv1.SetObjectReference(&req.Instance.Status.RelatedObjects, ref); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, ref := range baseRelatedObjects {
+		found, err := objectreferencesv1.FindObjectReference(opReq.Instance.Status.RelatedObjects, ref)
+		if err != nil {
+			return err
+		}
+
+		if found == nil {
+			if err = objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, ref); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package operandhandler
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+)
+
+var _ = Describe("Test independent operands", func() {
+	var (
+		hco          *hcov1beta1.HyperConverged
+		req          *common.HcoRequest
+		eventEmitter *commontestutils.EventEmitterMock
+		started      *sync.WaitGroup
+	)
+
+	newHandler := func(ops ...operands.Operand) *OperandHandler {
+		started.Add(len(ops))
+		return &OperandHandler{
+			client:              commontestutils.InitClient([]client.Object{hco}),
+			independentOperands: ops,
+			eventEmitter:        eventEmitter,
+		}
+	}
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		req = commontestutils.NewReq(hco)
+		eventEmitter = commontestutils.NewEventEmitterMock()
+		started = &sync.WaitGroup{}
+	})
+
+	It("should reconcile the independent operands concurrently, and merge the results in the operands order", func() {
+		handler := newHandler(
+			&fakeOperand{name: "first", delay: 50 * time.Millisecond, started: started},
+			&fakeOperand{name: "second", started: started},
+			&fakeOperand{name: "third", delay: 20 * time.Millisecond, started: started},
+		)
+
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(hco.Status.RelatedObjects).To(HaveLen(3))
+		Expect(hco.Status.RelatedObjects[0].Name).To(Equal("first"))
+		Expect(hco.Status.RelatedObjects[1].Name).To(Equal("second"))
+		Expect(hco.Status.RelatedObjects[2].Name).To(Equal("third"))
+		Expect(req.StatusDirty).To(BeTrue())
+
+		Expect(req.DriftRecords).To(HaveLen(3))
+		Expect(req.DriftRecords[0].Name).To(Equal("first"))
+		Expect(req.DriftRecords[1].Name).To(Equal("second"))
+		Expect(req.DriftRecords[2].Name).To(Equal("third"))
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionProgressing)
+		Expect(found).To(BeTrue())
+		Expect(cond.Message).To(Equal("third is progressing"))
+
+		Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{
+			{EventType: corev1.EventTypeNormal, Reason: "Created", Msg: "Created ConfigMap first"},
+			{EventType: corev1.EventTypeNormal, Reason: "Created", Msg: "Created ConfigMap second"},
+			{EventType: corev1.EventTypeNormal, Reason: "Created", Msg: "Created ConfigMap third"},
+		})).To(BeTrue())
+
+		for _, name := range []string{"first", "second", "third"} {
+			Expect(metrics.GetOperandReconcileCount("ConfigMap", name)).To(BeNumerically(">=", 1))
+			Expect(metrics.GetOperandEnsureResultCount("ConfigMap", name, metrics.EnsureResultCreated)).To(BeNumerically(">=", 1))
+		}
+	})
+
+	It("should return the error of the first failed operand", func() {
+		handler := newHandler(
+			&fakeOperand{name: "first", started: started},
+			&fakeOperand{name: "second", delay: 20 * time.Millisecond, err: errors.New("second error"), started: started},
+			&fakeOperand{name: "third", err: errors.New("third error"), started: started},
+		)
+
+		Expect(handler.Ensure(req)).To(MatchError("second error"))
+
+		cond, found := req.Conditions.GetCondition(hcov1beta1.ConditionReconcileComplete)
+		Expect(found).To(BeTrue())
+		Expect(cond.Status).To(Equal(metav1.ConditionFalse))
+		Expect(cond.Message).To(Equal("Error while reconciling: second error"))
+		Expect(req.ComponentUpgradeInProgress).To(BeFalse())
+
+		Expect(hco.Status.RelatedObjects).To(HaveLen(3))
+	})
+
+	It("should remove related objects removed by an independent operand", func() {
+		Expect(objectreferencesv1.SetObjectReference(&hco.Status.RelatedObjects, corev1.ObjectReference{
+			APIVersion: "v1", Kind: "ConfigMap", Name: "to-be-removed",
+		})).To(Succeed())
+
+		handler := newHandler(
+			&fakeOperand{name: "first", started: started},
+			&fakeOperand{name: "second", remove: "to-be-removed", started: started},
+		)
+
+		Expect(handler.Ensure(req)).To(Succeed())
+
+		Expect(hco.Status.RelatedObjects).To(HaveLen(2))
+		Expect(hco.Status.RelatedObjects[0].Name).To(Equal("first"))
+		Expect(hco.Status.RelatedObjects[1].Name).To(Equal("second"))
+	})
+})
+
+// fakeOperand waits for all the other fake operands to start, so it fails if the operands are not reconciled
+// concurrently
+type fakeOperand struct {
+	name    string
+	delay   time.Duration
+	err     error
+	remove  string
+	started *sync.WaitGroup
+}
+
+func (op *fakeOperand) Ensure(req *common.HcoRequest) *operands.EnsureResult {
+	res := &operands.EnsureResult{Type: "ConfigMap", Name: op.name}
+
+	op.started.Done()
+	allStarted := make(chan struct{})
+	go func() {
+		op.started.Wait()
+		close(allStarted)
+	}()
+
+	select {
+	case <-allStarted:
+	case <-time.After(5 * time.Second):
+		return res.Error(fmt.Errorf("%s: the operands are not reconciled concurrently", op.name))
+	}
+
+	time.Sleep(op.delay)
+
+	if err := objectreferencesv1.SetObjectReference(&req.Instance.Status.RelatedObjects, corev1.ObjectReference{
+		APIVersion: "v1", Kind: "ConfigMap", Name: op.name,
+	}); err != nil {
+		return res.Error(err)
+	}
+
+	if op.remove != "" {
+		if err := objectreferencesv1.RemoveObjectReference(&req.Instance.Status.RelatedObjects, corev1.ObjectReference{
+			APIVersion: "v1", Kind: "ConfigMap", Name: op.remove,
+		}); err != nil {
+			return res.Error(err)
+		}
+	}
+
+	req.DriftRecords = append(req.DriftRecords, common.DriftRecord{Kind: "ConfigMap", Name: op.name})
+	req.StatusDirty = true
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:    hcov1beta1.ConditionProgressing,
+		Status:  metav1.ConditionTrue,
+		Reason:  "FakeProgressing",
+		Message: op.name + " is progressing",
+	})
+
+	if op.err != nil {
+		return res.Error(op.err)
+	}
+
+	return res.SetCreated().SetUpgradeDone(true)
+}
+
+func (*fakeOperand) Reset() { /* no implementation */ }
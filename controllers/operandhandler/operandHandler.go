@@ -3,6 +3,7 @@ package operandhandler
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	"golang.org/x/sync/errgroup"
@@ -42,7 +43,11 @@ var (
 
 type OperandHandler struct {
 	client   client.Client
+	scheme   *runtime.Scheme
 	operands []operands.Operand
+	// operands that do not depend on each other, nor on the other operands; they are reconciled concurrently, after
+	// the operands above.
+	independentOperands []operands.Operand
 	// save for deletions
 	objects      []client.Object
 	eventEmitter hcoutil.EventEmitter
@@ -85,6 +90,7 @@ func NewOperandHandler(client client.Client, scheme *runtime.Scheme, ci hcoutil.
 
 	return &OperandHandler{
 		client:       client,
+		scheme:       scheme,
 		operands:     operandList,
 		eventEmitter: eventEmitter,
 	}
@@ -170,7 +176,7 @@ func (h *OperandHandler) addOperands(scheme *runtime.Scheme, hc *hcov1beta1.Hype
 		for _, handler := range handlers {
 			h.addOperandObject(handler, hc)
 		}
-		h.operands = append(h.operands, handlers...)
+		h.independentOperands = append(h.independentOperands, handlers...)
 	}
 }
 
@@ -183,38 +189,143 @@ func (h *OperandHandler) addOperand(scheme *runtime.Scheme, hc *hcov1beta1.Hyper
 
 	h.addOperandObject(handler, hc)
 
-	h.operands = append(h.operands, handler)
+	h.independentOperands = append(h.independentOperands, handler)
 }
 
 func (h *OperandHandler) Ensure(req *common.HcoRequest) error {
-	for _, handler := range h.operands {
-		res := handler.Ensure(req)
+	frozen := getFrozenOperands(req, slices.Concat(h.operands, h.independentOperands))
+
+	for i, handler := range h.operands {
+		if isFrozen(req, frozen, i) {
+			continue
+		}
+
+		res := ensureOperand(req, handler)
 		if res.Err != nil {
-			req.Logger.Error(res.Err, "failed to Ensure an operand")
-
-			req.ComponentUpgradeInProgress = false
-			req.Conditions.SetStatusCondition(metav1.Condition{
-				Type:               hcov1beta1.ConditionReconcileComplete,
-				Status:             metav1.ConditionFalse,
-				Reason:             reconcileFailed,
-				Message:            fmt.Sprintf("Error while reconciling: %v", res.Err),
-				ObservedGeneration: req.Instance.Generation,
-			})
+			h.handleFailedOperand(req, res)
 			return res.Err
 		}
 
-		if res.Created {
-			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", res.Type, res.Name))
-		} else if res.Updated {
-			h.handleUpdatedOperand(req, res)
-		} else if res.Deleted {
-			h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", res.Type, res.Name))
+		h.handleEnsureResult(req, res)
+	}
+
+	if err := h.ensureIndependentOperands(req, frozen); err != nil {
+		return err
+	}
+
+	return h.ensureExtensions(req)
+}
+
+func ensureOperand(req *common.HcoRequest, handler operands.Operand) *operands.EnsureResult {
+	start := time.Now()
+	res := handler.Ensure(req)
+	metrics.ObserveOperandReconcileDuration(res.Type, res.Name, time.Since(start))
+
+	if result := getEnsureResultLabel(res); result != "" {
+		metrics.IncOperandEnsureResult(res.Type, res.Name, result)
+	}
+
+	return res
+}
+
+// getEnsureResultLabel returns the result of ensuring an operand, as reported by the operand ensure results metric,
+// or an empty string if the operand was not modified.
+func getEnsureResultLabel(res *operands.EnsureResult) string {
+	switch {
+	case res.Err != nil:
+		return metrics.EnsureResultError
+	case res.Created:
+		return metrics.EnsureResultCreated
+	case res.Overwritten:
+		return metrics.EnsureResultOverwritten
+	case res.Updated:
+		return metrics.EnsureResultUpdated
+	case res.Deleted:
+		return metrics.EnsureResultDeleted
+	default:
+		return ""
+	}
+}
+
+// isFrozen checks if the i-th operand is frozen. A frozen operand is not upgraded, so the upgrade can't be completed.
+func isFrozen(req *common.HcoRequest, frozen []string, i int) bool {
+	if len(frozen) == 0 || frozen[i] == "" {
+		return false
+	}
+
+	req.Logger.Info("skipping a frozen operand", "operand", frozen[i])
+	setUpgradeBlockingOperand(req, frozen[i])
+	req.ComponentUpgradeInProgress = false
+	return true
+}
+
+func (h *OperandHandler) handleFailedOperand(req *common.HcoRequest, res *operands.EnsureResult) {
+	req.Logger.Error(res.Err, "failed to Ensure an operand")
+
+	if res.Type != "" {
+		setUpgradeBlockingOperand(req, res.Type+"/"+res.Name)
+	}
+	req.ComponentUpgradeInProgress = false
+	req.Conditions.SetStatusCondition(metav1.Condition{
+		Type:               hcov1beta1.ConditionReconcileComplete,
+		Status:             metav1.ConditionFalse,
+		Reason:             reconcileFailed,
+		Message:            fmt.Sprintf("Error while reconciling: %v", res.Err),
+		ObservedGeneration: req.Instance.Generation,
+	})
+}
+
+func (h *OperandHandler) handleEnsureResult(req *common.HcoRequest, res *operands.EnsureResult) {
+	if res.Created {
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Created", fmt.Sprintf("Created %s %s", res.Type, res.Name))
+	} else if res.Updated {
+		h.handleUpdatedOperand(req, res)
+	} else if res.Deleted {
+		h.eventEmitter.EmitEvent(req.Instance, corev1.EventTypeNormal, "Killing", fmt.Sprintf("Removed %s %s", res.Type, res.Name))
+	}
+
+	updateOperandVersions(req, res)
+
+	if !res.UpgradeDone {
+		setUpgradeBlockingOperand(req, res.Type+"/"+res.Name)
+	}
+	req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && res.UpgradeDone
+}
+
+// updateOperandVersions reflects the versions of an operand in the HyperConverged status, and in the operand version
+// metric. The operand is removed from both, if it was deleted.
+func updateOperandVersions(req *common.HcoRequest, res *operands.EnsureResult) {
+	versions := req.Instance.Status.OperandVersions
+	idx := slices.IndexFunc(versions, func(v hcov1beta1.OperandVersion) bool {
+		return v.Name == res.Type
+	})
+
+	switch {
+	case res.Deleted:
+		metrics.DeleteOperandVersion(res.Type, res.Name)
+		if idx >= 0 {
+			req.Instance.Status.OperandVersions = slices.Delete(versions, idx, idx+1)
+			req.StatusDirty = true
 		}
 
-		req.ComponentUpgradeInProgress = req.ComponentUpgradeInProgress && res.UpgradeDone
+	case res.Version != nil:
+		metrics.SetOperandVersion(res.Type, res.Name, res.Version.ObservedVersion, res.Version.TargetVersion)
+		if idx < 0 {
+			req.Instance.Status.OperandVersions = append(versions, *res.Version)
+			req.StatusDirty = true
+		} else if versions[idx] != *res.Version {
+			versions[idx] = *res.Version
+			req.StatusDirty = true
+		}
 	}
-	return nil
+}
 
+// setUpgradeBlockingOperand records the first operand that is not upgraded yet, in upgrade mode; the operand is in the
+// form of <kind>/<name>
+func setUpgradeBlockingOperand(req *common.HcoRequest, operand string) {
+	if req.UpgradeMode && req.UpgradeBlockingOperand == "" {
+		req.UpgradeBlockingOperand = operand
+	}
 }
 
 func (h *OperandHandler) handleUpdatedOperand(req *common.HcoRequest, res *operands.EnsureResult) {
@@ -278,11 +389,15 @@ func (h *OperandHandler) EnsureDeleted(req *common.HcoRequest) error {
 		}(res)
 	}
 
-	return eg.Wait()
+	if err := eg.Wait(); err != nil {
+		return err
+	}
+
+	return h.removeExtensions(req)
 }
 
 func (h *OperandHandler) Reset() {
-	for _, op := range h.operands {
+	for _, op := range slices.Concat(h.operands, h.independentOperands) {
 		op.Reset()
 	}
 }
@@ -15,6 +15,7 @@ import (
 	imagev1 "github.com/openshift/api/image/v1"
 	objectreferencesv1 "github.com/openshift/custom-resource-status/objectreferences/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/reference"
@@ -26,8 +27,11 @@ import (
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 )
 
 func TestOperators(t *testing.T) {
@@ -218,6 +222,54 @@ var _ = Describe("Test operandHandler", func() {
 			})
 		})
 
+		It("should skip the frozen operands", func() {
+			hco := commontestutils.NewHco()
+			hco.Annotations = map[string]string{
+				common.FrozenOperandsAnnotationName: "cdi/cdi-kubevirt-hyperconverged, ConfigMap/grafana-dashboard-kubevirt-top-consumers,wrong-item",
+			}
+			ci := commontestutils.ClusterInfoMock{}
+			cli := commontestutils.InitClient([]client.Object{hcoNamespace, hco, ci.GetCSV()})
+
+			eventEmitter := commontestutils.NewEventEmitterMock()
+
+			handler := NewOperandHandler(cli, commontestutils.GetScheme(), ci, eventEmitter)
+			handler.FirstUseInitiation(commontestutils.GetScheme(), ci, hco)
+
+			req := commontestutils.NewReq(hco)
+			req.SetUpgradeMode(true)
+
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(req.FrozenOperands).To(ConsistOf("CDI/cdi-kubevirt-hyperconverged", "ConfigMap/grafana-dashboard-kubevirt-top-consumers"))
+			Expect(req.ComponentUpgradeInProgress).To(BeFalse())
+
+			cdiList := cdiv1beta1.CDIList{}
+			Expect(cli.List(req.Ctx, &cdiList)).To(Succeed())
+			Expect(cdiList.Items).To(BeEmpty())
+
+			cm := &corev1.ConfigMap{}
+			Expect(cli.Get(req.Ctx, client.ObjectKey{Namespace: hco.Namespace, Name: "grafana-dashboard-kubevirt-top-consumers"}, cm)).To(MatchError(apierrors.IsNotFound, "not found error"))
+
+			kvList := kubevirtcorev1.KubeVirtList{}
+			Expect(cli.List(req.Ctx, &kvList)).To(Succeed())
+			Expect(kvList.Items).To(HaveLen(1))
+
+			Expect(metrics.IsOperandFrozen("CDI", "cdi-kubevirt-hyperconverged")).To(BeTrue())
+			Expect(metrics.IsOperandFrozen("KubeVirt", "kubevirt-kubevirt-hyperconverged")).To(BeFalse())
+
+			By("unfreeze the operands")
+			delete(hco.Annotations, common.FrozenOperandsAnnotationName)
+			req = commontestutils.NewReq(hco)
+
+			Expect(handler.Ensure(req)).To(Succeed())
+			Expect(req.FrozenOperands).To(BeEmpty())
+			Expect(req.FrozenOperands).ToNot(BeNil())
+
+			Expect(cli.List(req.Ctx, &cdiList)).To(Succeed())
+			Expect(cdiList.Items).To(HaveLen(1))
+
+			Expect(metrics.IsOperandFrozen("CDI", "cdi-kubevirt-hyperconverged")).To(BeFalse())
+		})
+
 		It("make sure the all objects are deleted", func() {
 			hco := commontestutils.NewHco()
 			ci := commontestutils.ClusterInfoMock{}
@@ -499,6 +551,86 @@ var _ = Describe("Test operandHandler", func() {
 		})
 	})
 
+	Context("test the operand versions and the upgrade blocking operand", func() {
+		var (
+			hco     *hcov1beta1.HyperConverged
+			req     *common.HcoRequest
+			handler *OperandHandler
+		)
+
+		BeforeEach(func() {
+			hco = commontestutils.NewHco()
+			req = commontestutils.NewReq(hco)
+			handler = &OperandHandler{eventEmitter: commontestutils.NewEventEmitterMock()}
+		})
+
+		newResult := func(kind, name, observedVersion, targetVersion string) *operands.EnsureResult {
+			res := &operands.EnsureResult{Type: kind, Name: name}
+			return res.SetVersion(observedVersion, targetVersion)
+		}
+
+		It("should add, update and remove the operand versions", func() {
+			handler.handleEnsureResult(req, newResult("KubeVirt", "kubevirt-kubevirt-hyperconverged", "1.0.0", "1.1.0"))
+			handler.handleEnsureResult(req, newResult("CDI", "cdi-kubevirt-hyperconverged", "1.1.0", "1.1.0"))
+			handler.handleEnsureResult(req, &operands.EnsureResult{Type: "ConfigMap", Name: "some-cm"})
+
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.OperandVersions).To(Equal([]hcov1beta1.OperandVersion{
+				{Name: "KubeVirt", ObservedVersion: "1.0.0", TargetVersion: "1.1.0"},
+				{Name: "CDI", ObservedVersion: "1.1.0", TargetVersion: "1.1.0"},
+			}))
+
+			value, observed, _, found, err := metrics.GetOperandVersion("KubeVirt", "kubevirt-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(BeZero())
+			Expect(observed).To(Equal("1.0.0"))
+
+			By("the version is not modified")
+			req.StatusDirty = false
+			handler.handleEnsureResult(req, newResult("CDI", "cdi-kubevirt-hyperconverged", "1.1.0", "1.1.0"))
+			Expect(req.StatusDirty).To(BeFalse())
+
+			By("the operand is upgraded")
+			handler.handleEnsureResult(req, newResult("KubeVirt", "kubevirt-kubevirt-hyperconverged", "1.1.0", "1.1.0"))
+			Expect(req.StatusDirty).To(BeTrue())
+			Expect(hco.Status.OperandVersions[0].ObservedVersion).To(Equal("1.1.0"))
+
+			value, _, _, _, err = metrics.GetOperandVersion("KubeVirt", "kubevirt-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(value).To(Equal(1.0))
+
+			By("the operand is removed")
+			handler.handleEnsureResult(req, (&operands.EnsureResult{Type: "CDI", Name: "cdi-kubevirt-hyperconverged"}).SetDeleted())
+			Expect(hco.Status.OperandVersions).To(HaveLen(1))
+			Expect(hco.Status.OperandVersions[0].Name).To(Equal("KubeVirt"))
+
+			_, _, _, found, err = metrics.GetOperandVersion("CDI", "cdi-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("should record the first operand that is not upgraded, in upgrade mode", func() {
+			req.SetUpgradeMode(true)
+
+			handler.handleEnsureResult(req, newResult("KubeVirt", "kubevirt-kubevirt-hyperconverged", "1.1.0", "1.1.0").SetUpgradeDone(true))
+			handler.handleEnsureResult(req, newResult("CDI", "cdi-kubevirt-hyperconverged", "1.0.0", "1.1.0"))
+			handler.handleEnsureResult(req, newResult("SSP", "ssp-kubevirt-hyperconverged", "1.0.0", "1.1.0"))
+
+			Expect(req.UpgradeBlockingOperand).To(Equal("CDI/cdi-kubevirt-hyperconverged"))
+			Expect(req.ComponentUpgradeInProgress).To(BeFalse())
+
+			req.SetUpgradeMode(true)
+			Expect(req.UpgradeBlockingOperand).To(BeEmpty())
+		})
+
+		It("should not record a blocking operand, if not in upgrade mode", func() {
+			handler.handleEnsureResult(req, newResult("CDI", "cdi-kubevirt-hyperconverged", "1.0.0", "1.1.0"))
+
+			Expect(req.UpgradeBlockingOperand).To(BeEmpty())
+		})
+	})
+
 	Context("test imageStream deletion", func() {
 		It("should delete the ImageStream resource if the FG is not set, and emit event", func() {
 			hcoNamespace := commontestutils.NewHcoNamespace()
@@ -8,6 +8,7 @@ import (
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -46,6 +47,9 @@ func (ch *ConditionalHandler) ensureDeleted(req *common.HcoRequest) *EnsureResul
 	res := NewEnsureResult(req.Instance)
 	res.SetName(cr.GetName())
 
+	// the operand is not deployed, so it has no conditions to report
+	metrics.DeleteOperandConditions(ch.operand.crType, cr.GetName())
+
 	// hcoutil.EnsureDeleted does check that the CR exists before removing it. But it also writes a log message each
 	// time it happens, i.e. for every reconcile loop. Assuming the client cache is up-to-date, we can safely get it here
 	// with no meaningful performance cost.
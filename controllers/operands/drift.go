@@ -0,0 +1,133 @@
+package operands
+
+import (
+	"encoding/json"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gomodules.xyz/jsonpatch/v2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// reportDrift records the fields of an operand CR, that were modified out of band and then reverted by HCO. The
+// record is logged, and added to the request, to be later stored in the drift report.
+func (h *GenericOperand) reportDrift(req *common.HcoRequest, before, after client.Object) {
+	changes, err := GetFieldDrift(before, after)
+	if err != nil {
+		req.Logger.Error(err, "failed to compute the drift of the "+h.crType, "name", after.GetName())
+		return
+	}
+
+	if len(changes) == 0 {
+		return
+	}
+
+	record := common.DriftRecord{
+		Kind:      h.crType,
+		Name:      after.GetName(),
+		Namespace: after.GetNamespace(),
+		Timestamp: metav1.NewTime(time.Now().UTC().Truncate(time.Second)),
+		Changes:   changes,
+	}
+
+	req.Logger.Info("reverted an out-of-band modification", "kind", record.Kind, "name", record.Name, "namespace", record.Namespace, "changes", changes)
+	req.DriftRecords = append(req.DriftRecords, record)
+}
+
+// GetFieldDrift returns the field-level difference between two versions of an object; e.g. the modified object and
+// the object after HCO reverted it. The status, and the metadata except for the labels and the annotations, are ignored, as they are maintained by
+// the API server or by the operand itself.
+func GetFieldDrift(before, after client.Object) ([]common.FieldDrift, error) {
+	beforeJSON, err := json.Marshal(before)
+	if err != nil {
+		return nil, err
+	}
+
+	afterJSON, err := json.Marshal(after)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := jsonpatch.CreatePatch(beforeJSON, afterJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	var beforeDoc any
+	if err = json.Unmarshal(beforeJSON, &beforeDoc); err != nil {
+		return nil, err
+	}
+
+	ops = slices.DeleteFunc(ops, func(op jsonpatch.Operation) bool {
+		return !isDriftPath(op.Path)
+	})
+
+	slices.SortStableFunc(ops, func(a, b jsonpatch.Operation) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	changes := make([]common.FieldDrift, 0, len(ops))
+	for _, op := range ops {
+		change := common.FieldDrift{
+			Path: op.Path,
+			Op:   op.Operation,
+			To:   op.Value,
+		}
+
+		if op.Operation != "add" {
+			change.From = getValueByPointer(beforeDoc, op.Path)
+		}
+
+		changes = append(changes, change)
+	}
+
+	return changes, nil
+}
+
+func isDriftPath(path string) bool {
+	for _, prefix := range []string{"/metadata/labels", "/metadata/annotations"} {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return true
+		}
+	}
+
+	for _, prefix := range []string{"/metadata", "/status", "/apiVersion", "/kind"} {
+		if path == prefix || strings.HasPrefix(path, prefix+"/") {
+			return false
+		}
+	}
+
+	return true
+}
+
+// getValueByPointer returns the value in the JSON document, in the location of the JSON pointer; nil if there is no
+// such location in the document.
+func getValueByPointer(doc any, pointer string) any {
+	if pointer == "" {
+		return doc
+	}
+
+	for token := range strings.SplitSeq(strings.TrimPrefix(pointer, "/"), "/") {
+		token = strings.ReplaceAll(strings.ReplaceAll(token, "~1", "/"), "~0", "~")
+
+		switch node := doc.(type) {
+		case map[string]any:
+			doc = node[token]
+		case []any:
+			idx, err := strconv.Atoi(token)
+			if err != nil || idx < 0 || idx >= len(node) {
+				return nil
+			}
+			doc = node[idx]
+		default:
+			return nil
+		}
+	}
+
+	return doc
+}
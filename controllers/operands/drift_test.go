@@ -0,0 +1,183 @@
+package operands
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Test drift report", func() {
+	const cmName = "test-cm"
+
+	newRequiredCM := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cmName,
+				Namespace: commontestutils.Namespace,
+				Labels:    map[string]string{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+			},
+			Data: map[string]string{"key": "value", "other": "value"},
+		}
+	}
+
+	Context("GetFieldDrift", func() {
+		It("should return the modified fields, with their out-of-band and reverted values", func() {
+			before := newRequiredCM()
+			before.Data["key"] = "modified"
+			delete(before.Data, "other")
+			before.Data["added"] = "value"
+			before.ResourceVersion = "1"
+
+			after := newRequiredCM()
+			after.ResourceVersion = "2"
+
+			changes, err := GetFieldDrift(before, after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(Equal([]common.FieldDrift{
+				{Path: "/data/added", Op: "remove", From: "value"},
+				{Path: "/data/key", Op: "replace", From: "modified", To: "value"},
+				{Path: "/data/other", Op: "add", To: "value"},
+			}))
+		})
+
+		It("should ignore the metadata, except for labels and annotations", func() {
+			before := newRequiredCM()
+			before.ResourceVersion = "1"
+			before.Generation = 1
+			before.Labels[hcoutil.AppLabel] = "modified"
+			before.Annotations = map[string]string{"a/b": "c"}
+
+			after := newRequiredCM()
+			after.ResourceVersion = "2"
+			after.Generation = 2
+			after.Annotations = map[string]string{"d": "e"}
+
+			changes, err := GetFieldDrift(before, after)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changes).To(Equal([]common.FieldDrift{
+				{Path: "/metadata/annotations/a~1b", Op: "remove", From: "c"},
+				{Path: "/metadata/annotations/d", Op: "add", To: "e"},
+				{Path: "/metadata/labels/app", Op: "replace", From: "modified", To: hcoutil.HyperConvergedName},
+			}))
+		})
+
+		It("should return no changes if only the metadata was changed", func() {
+			before := newRequiredCM()
+			before.ResourceVersion = "1"
+
+			after := newRequiredCM()
+			after.ResourceVersion = "2"
+
+			Expect(GetFieldDrift(before, after)).To(BeEmpty())
+		})
+	})
+
+	Context("getValueByPointer", func() {
+		doc := map[string]any{
+			"spec": map[string]any{
+				"list": []any{"a", "b"},
+				"a/b":  "slash",
+				"a~b":  "tilde",
+			},
+		}
+
+		DescribeTable("should find the value", func(pointer string, expected any) {
+			Expect(getValueByPointer(doc, pointer)).To(Equal(expected))
+		},
+			Entry("map field", "/spec/a~1b", "slash"),
+			Entry("escaped tilde", "/spec/a~0b", "tilde"),
+			Entry("list item", "/spec/list/1", "b"),
+		)
+
+		DescribeTable("should return nil if there is no such location", func(pointer string) {
+			Expect(getValueByPointer(doc, pointer)).To(BeNil())
+		},
+			Entry("list out of range", "/spec/list/2"),
+			Entry("not a list index", "/spec/list/x"),
+			Entry("missing field", "/spec/missing/field"),
+		)
+	})
+
+	Context("GenericOperand", func() {
+		It("should add a drift record, when overwriting an out-of-band modification", func() {
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			cl := fake.NewClientBuilder().WithScheme(commontestutils.GetScheme()).WithObjects(existing).Build()
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			req := commontestutils.NewReq(commontestutils.NewHco())
+			req.HCOTriggered = false
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Overwritten).To(BeTrue())
+
+			Expect(req.DriftRecords).To(HaveLen(1))
+			record := req.DriftRecords[0]
+			Expect(record.Kind).To(Equal("ConfigMap"))
+			Expect(record.Name).To(Equal(cmName))
+			Expect(record.Namespace).To(Equal(commontestutils.Namespace))
+			Expect(record.Timestamp.IsZero()).To(BeFalse())
+			Expect(record.Changes).To(Equal([]common.FieldDrift{
+				{Path: "/data/key", Op: "replace", From: "modified", To: "value"},
+			}))
+		})
+
+		It("should add a drift record, when the cache still holds the modified object after the update", func() {
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			// simulate a stale cache, that returns the modified object also after HCO updated it
+			staleGet := func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				existing.DeepCopyInto(obj.(*corev1.ConfigMap))
+				return nil
+			}
+
+			cl := fake.NewClientBuilder().
+				WithScheme(commontestutils.GetScheme()).
+				WithObjects(existing.DeepCopy()).
+				WithInterceptorFuncs(interceptor.Funcs{Get: staleGet}).
+				Build()
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			req := commontestutils.NewReq(commontestutils.NewHco())
+			req.HCOTriggered = false
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Overwritten).To(BeTrue())
+
+			Expect(req.DriftRecords).To(HaveLen(1))
+			Expect(req.DriftRecords[0].Changes).To(Equal([]common.FieldDrift{
+				{Path: "/data/key", Op: "replace", From: "modified", To: "value"},
+			}))
+		})
+
+		It("should not add a drift record, when the update was triggered by a change in the HyperConverged CR", func() {
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			cl := fake.NewClientBuilder().WithScheme(commontestutils.GetScheme()).WithObjects(existing).Build()
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			req := commontestutils.NewReq(commontestutils.NewHco())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeFalse())
+			Expect(req.DriftRecords).To(BeEmpty())
+		})
+	})
+})
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"k8s.io/apimachinery/pkg/runtime"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 )
 
 type EnsureResult struct {
@@ -16,6 +18,8 @@ type EnsureResult struct {
 	Err         error
 	Type        string
 	Name        string
+	// Version holds the observed and the expected versions of an operand that reports its version; nil otherwise
+	Version *hcov1beta1.OperandVersion
 }
 
 func NewEnsureResult(resource runtime.Object) *EnsureResult {
@@ -58,3 +62,12 @@ func (r *EnsureResult) SetDeleted() *EnsureResult {
 	r.Deleted = true
 	return r
 }
+
+func (r *EnsureResult) SetVersion(observedVersion, targetVersion string) *EnsureResult {
+	r.Version = &hcov1beta1.OperandVersion{
+		Name:            r.Type,
+		ObservedVersion: observedVersion,
+		TargetVersion:   targetVersion,
+	}
+	return r
+}
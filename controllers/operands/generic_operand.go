@@ -2,16 +2,20 @@ package operands
 
 import (
 	"fmt"
+	"slices"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -28,6 +32,8 @@ type GenericOperand struct {
 	setControllerReference bool
 	// Set of resource handler hooks, to be implemented in each handler
 	hooks HCOResourceHooks
+	// the hashes of the last server-side applied resources, by their UID
+	lastApplied map[types.UID]string
 }
 
 func NewGenericOperand(client client.Client, scheme *runtime.Scheme, crType string, hooks HCOResourceHooks, setControllerReference bool) *GenericOperand {
@@ -85,11 +91,31 @@ func (h *GenericOperand) Ensure(req *common.HcoRequest) *EnsureResult {
 func (h *GenericOperand) handleExistingCr(req *common.HcoRequest, key client.ObjectKey, found client.Object, cr client.Object, res *EnsureResult) *EnsureResult {
 	req.Logger.Info(h.crType+" already exists", h.crType+".Namespace", key.Namespace, h.crType+".Name", key.Name)
 
-	updated, overwritten, err := h.hooks.UpdateCR(req, h.Client, found, cr)
+	var (
+		written              client.Object
+		updated, overwritten bool
+		err                  error
+	)
+
+	// keep the modified object, to report the drift if HCO overwrites it
+	before := found.DeepCopyObject().(client.Object)
+
+	if isServerSideApplyEnabled(req) {
+		written, updated, overwritten, err = h.applyCr(req, found, cr)
+	} else {
+		// UpdateCR modifies found to the written object
+		updated, overwritten, err = h.hooks.UpdateCR(req, h.Client, found, cr)
+		written = found
+	}
 	if err != nil {
 		return res.Error(err)
 	}
 	if updated {
+		// diff against the written object; the cache may still hold the modified object, right after the write
+		if overwritten {
+			h.reportDrift(req, before, written)
+		}
+
 		// refresh the object
 		err = h.Get(req.Ctx, key, found)
 		if err != nil {
@@ -150,13 +176,19 @@ func (h *GenericOperand) handleExistingCrSkipCache(req *common.HcoRequest, key c
 
 func (h *GenericOperand) completeEnsureOperands(req *common.HcoRequest, opr HCOOperandHooks, found client.Object, res *EnsureResult) *EnsureResult {
 	// Handle KubeVirt resource conditions
-	isReady := handleComponentConditions(req, h.crType, opr.GetConditions(found))
+	conditions := opr.GetConditions(found)
+	isReady := handleComponentConditions(req, h.crType, conditions)
+	reportOperandConditions(h.crType, found.GetName(), conditions, req.Instance.Generation)
 
 	versionUpdated := opr.CheckComponentVersion(found)
 	if isReady && !versionUpdated {
 		req.Logger.Info(fmt.Sprintf("could not complete the upgrade process. %s is not with the expected version. Check %s observed version in the status field of its CR", h.crType, h.crType))
 	}
 
+	if versioned, ok := opr.(VersionedOperandHooks); ok {
+		res.SetVersion(versioned.GetComponentVersions(found))
+	}
+
 	upgradeDone := req.UpgradeMode && isReady && versionUpdated
 	return res.SetUpgradeDone(upgradeDone)
 }
@@ -253,30 +285,55 @@ func setConditionsByOperandConditions(req *common.HcoRequest, component string,
 }
 
 func getConditionsForNewCr(req *common.HcoRequest, component string) {
+	req.Logger.Info(fmt.Sprintf("%s's resource is not reporting Conditions on it's Status", component))
+	for _, cond := range newCrConditions(component, req.Instance.Generation) {
+		req.Conditions.SetStatusCondition(cond)
+	}
+}
+
+// newCrConditions returns the conditions that represent an operand CR that does not report conditions yet
+func newCrConditions(component string, generation int64) []metav1.Condition {
 	reason := fmt.Sprintf("%sConditions", component)
 	message := fmt.Sprintf("%s resource has no conditions", component)
-	req.Logger.Info(fmt.Sprintf("%s's resource is not reporting Conditions on it's Status", component))
-	req.Conditions.SetStatusCondition(metav1.Condition{
-		Type:               hcov1beta1.ConditionAvailable,
-		Status:             metav1.ConditionFalse,
-		Reason:             reason,
-		Message:            message,
-		ObservedGeneration: req.Instance.Generation,
-	})
-	req.Conditions.SetStatusCondition(metav1.Condition{
-		Type:               hcov1beta1.ConditionProgressing,
-		Status:             metav1.ConditionTrue,
-		Reason:             reason,
-		Message:            message,
-		ObservedGeneration: req.Instance.Generation,
-	})
-	req.Conditions.SetStatusCondition(metav1.Condition{
-		Type:               hcov1beta1.ConditionUpgradeable,
-		Status:             metav1.ConditionFalse,
-		Reason:             reason,
-		Message:            message,
-		ObservedGeneration: req.Instance.Generation,
-	})
+	return []metav1.Condition{
+		{
+			Type:               hcov1beta1.ConditionAvailable,
+			Status:             metav1.ConditionFalse,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: generation,
+		},
+		{
+			Type:               hcov1beta1.ConditionProgressing,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: generation,
+		},
+		{
+			Type:               hcov1beta1.ConditionUpgradeable,
+			Status:             metav1.ConditionFalse,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: generation,
+		},
+	}
+}
+
+// reportOperandConditions reports the conditions of the operand CR as metrics. An operand with no conditions, or with
+// no Available condition, is reported the same way it is aggregated into the HyperConverged conditions.
+func reportOperandConditions(kind, name string, componentConds []metav1.Condition, generation int64) {
+	if len(componentConds) == 0 {
+		componentConds = newCrConditions(kind, generation)
+	} else if meta.FindStatusCondition(componentConds, hcov1beta1.ConditionAvailable) == nil {
+		componentConds = append(slices.Clone(componentConds), metav1.Condition{
+			Type:   hcov1beta1.ConditionAvailable,
+			Status: metav1.ConditionFalse,
+			Reason: fmt.Sprintf("%sNotAvailable", kind),
+		})
+	}
+
+	metrics.SetOperandConditions(kind, name, componentConds)
 }
 
 func handleOperandAvailableCond(req *common.HcoRequest, component string, condition metav1.Condition) bool {
@@ -1,10 +1,12 @@
 package operands
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"os"
+	"reflect"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -50,6 +52,13 @@ type HCOOperandHooks interface {
 	CheckComponentVersion(runtime.Object) bool
 }
 
+// VersionedOperandHooks is implemented by the hooks of the operands that report their version, to expose the observed
+// and the expected versions in the HyperConverged status
+type VersionedOperandHooks interface {
+	// GetComponentVersions returns the version the CR reports, and the version HCO expects
+	GetComponentVersions(runtime.Object) (string, string)
+}
+
 type Reseter interface {
 	// Reset handler cached, if exists
 	Reset()
@@ -116,6 +125,10 @@ func CheckComponentVersion(versionEnvName, actualVersion string) bool {
 	return expectedVersion != "" && expectedVersion == actualVersion
 }
 
+func GetComponentVersions(versionEnvName, actualVersion string) (string, string) {
+	return actualVersion, os.Getenv(versionEnvName)
+}
+
 func GetNamespace(defaultNamespace string, opts []string) string {
 	if len(opts) > 0 {
 		return opts[0]
@@ -151,6 +164,54 @@ func applyAnnotationPatch(obj runtime.Object, annotation string) error {
 	return json.Unmarshal(patchedBytes, obj)
 }
 
+// ValidateAnnotationPatch applies the jsonpatch annotation to the given operand CR, one operation at a time, and
+// checks that the result is still a valid CR; i.e. that each operation only modifies existing fields of the CR spec.
+// Returns the paths modified by the patch.
+func ValidateAnnotationPatch(obj runtime.Object, annotation string) ([]string, error) {
+	patches, err := jsonpatch.DecodePatch([]byte(annotation))
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for i, patch := range patches {
+		path, err := patch.Path()
+		if err != nil {
+			return nil, fmt.Errorf("operation #%d: %w", i, err)
+		}
+
+		desc := fmt.Sprintf("operation #%d (%s %s)", i, patch.Kind(), path)
+		if !strings.HasPrefix(path, "/spec/") {
+			return nil, fmt.Errorf("%s: can only modify spec fields", desc)
+		}
+
+		if from, err := patch.From(); err == nil && !strings.HasPrefix(from, "/spec/") {
+			return nil, fmt.Errorf("%s: can only copy or move spec fields", desc)
+		}
+
+		if doc, err = (jsonpatch.Patch{patch}).Apply(doc); err != nil {
+			return nil, fmt.Errorf("%s: %w", desc, err)
+		}
+
+		decoder := json.NewDecoder(bytes.NewReader(doc))
+		decoder.DisallowUnknownFields()
+		if err = decoder.Decode(reflect.New(reflect.TypeOf(obj).Elem()).Interface()); err != nil {
+			return nil, fmt.Errorf("%s: %w", desc, err)
+		}
+
+		if patch.Kind() != "test" {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths, nil
+}
+
 func ApplyPatchToSpec(hc *hcov1beta1.HyperConverged, annotationName string, obj runtime.Object) error {
 	if jsonpathAnnotation, ok := hc.Annotations[annotationName]; ok {
 		if err := applyAnnotationPatch(obj, jsonpathAnnotation); err != nil {
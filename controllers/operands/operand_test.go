@@ -14,7 +14,9 @@ import (
 
 	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
 
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 )
 
 var _ = Describe("Test operator.go", func() {
@@ -92,6 +94,54 @@ var _ = Describe("Test operator.go", func() {
 		})
 	})
 
+	Context("Test ValidateAnnotationPatch", func() {
+		It("Should return the modified paths, without the test operations", func() {
+			obj := &cdiv1beta1.CDI{
+				Spec: cdiv1beta1.CDISpec{
+					Config: &cdiv1beta1.CDIConfigSpec{
+						FeatureGates: []string{"fg1"},
+					},
+				},
+			}
+
+			paths, err := ValidateAnnotationPatch(obj, `[
+				{"op": "test", "path": "/spec/config/featureGates/0", "value": "fg1"},
+				{"op": "add", "path": "/spec/config/featureGates/-", "value": "fg2"},
+				{"op": "add", "path": "/spec/config/filesystemOverhead", "value": {"global": "55"}}
+			]`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(paths).To(Equal([]string{"/spec/config/featureGates/-", "/spec/config/filesystemOverhead"}))
+
+			By("not modifying the object")
+			Expect(obj.Spec.Config.FeatureGates).To(Equal([]string{"fg1"}))
+			Expect(obj.Spec.Config.FilesystemOverhead).To(BeNil())
+		})
+
+		It("Should fail for a path that is not under '/spec/'", func() {
+			obj := &cdiv1beta1.CDI{}
+
+			_, err := ValidateAnnotationPatch(obj, `[{"op": "add", "path": "/metadata/labels", "value": {"a": "b"}}]`)
+			Expect(err).To(MatchError("operation #0 (add /metadata/labels): can only modify spec fields"))
+		})
+
+		It("Should fail for an unknown field", func() {
+			obj := &cdiv1beta1.CDI{Spec: cdiv1beta1.CDISpec{Config: &cdiv1beta1.CDIConfigSpec{}}}
+
+			_, err := ValidateAnnotationPatch(obj, `[
+				{"op": "add", "path": "/spec/config/featureGates", "value": ["fg1"]},
+				{"op": "add", "path": "/spec/config/featureGatez", "value": ["fg2"]}
+			]`)
+			Expect(err).To(MatchError(ContainSubstring(`operation #1 (add /spec/config/featureGatez): json: unknown field "featureGatez"`)))
+		})
+
+		It("Should fail for a wrong value type", func() {
+			obj := &cdiv1beta1.CDI{Spec: cdiv1beta1.CDISpec{Config: &cdiv1beta1.CDIConfigSpec{}}}
+
+			_, err := ValidateAnnotationPatch(obj, `[{"op": "add", "path": "/spec/config/featureGates", "value": "fg1"}]`)
+			Expect(err).To(MatchError(ContainSubstring("operation #0 (add /spec/config/featureGates): json: cannot unmarshal string")))
+		})
+	})
+
 	Context("Test addCrToTheRelatedObjectList", func() {
 		It("Should return error when apiVersion, kind and name missing", func() {
 			hco := commontestutils.NewHco()
@@ -239,6 +289,57 @@ var _ = Describe("Test operator.go", func() {
 
 	})
 
+	Context("Test reportOperandConditions", func() {
+		BeforeEach(func() {
+			metrics.ResetOperandConditions()
+		})
+
+		It("should report the conditions of the operand", func() {
+			reportOperandConditions("CDI", "cdi-test", []metav1.Condition{
+				{Type: hcov1beta1.ConditionAvailable, Status: metav1.ConditionTrue, Reason: "AsExpected"},
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionTrue, Reason: "Failing"},
+			}, 1)
+
+			value, reason, found, err := metrics.GetOperandCondition("CDI", "cdi-test", hcov1beta1.ConditionAvailable)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(metrics.OperandConditionTrue))
+			Expect(reason).To(Equal("AsExpected"))
+
+			value, reason, found, err = metrics.GetOperandCondition("CDI", "cdi-test", hcov1beta1.ConditionDegraded)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(metrics.OperandConditionTrue))
+			Expect(reason).To(Equal("Failing"))
+		})
+
+		It("should report an operand with no conditions as a new operand", func() {
+			reportOperandConditions("CDI", "cdi-test", nil, 1)
+
+			value, reason, found, err := metrics.GetOperandCondition("CDI", "cdi-test", hcov1beta1.ConditionAvailable)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(metrics.OperandConditionFalse))
+			Expect(reason).To(Equal("CDIConditions"))
+
+			value, _, found, err = metrics.GetOperandCondition("CDI", "cdi-test", hcov1beta1.ConditionProgressing)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(metrics.OperandConditionTrue))
+		})
+
+		It("should report a missing Available condition as not available", func() {
+			reportOperandConditions("CDI", "cdi-test", []metav1.Condition{
+				{Type: hcov1beta1.ConditionDegraded, Status: metav1.ConditionFalse, Reason: "AsExpected"},
+			}, 1)
+
+			value, reason, found, err := metrics.GetOperandCondition("CDI", "cdi-test", hcov1beta1.ConditionAvailable)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(metrics.OperandConditionFalse))
+			Expect(reason).To(Equal("CDINotAvailable"))
+		})
+	})
 })
 
 func newCDI() *cdiv1beta1.CDI {
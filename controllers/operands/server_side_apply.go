@@ -0,0 +1,192 @@
+package operands
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/csaupgrade"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+func isServerSideApplyEnabled(req *common.HcoRequest) bool {
+	return req.Instance.Annotations[common.ServerSideApplyAnnotationName] == "true"
+}
+
+// applyCr reconciles an existing resource using server-side apply, with the HCO field manager. Only the fields HCO
+// sets in the required resource are owned by HCO; fields added by other controllers or by users, like labels and
+// annotations, are left intact, and are not considered as an out-of-band modification.
+//
+// The apply request is skipped if the same required resource was already applied to the existing resource, and the
+// existing resource still holds all the fields HCO sets.
+//
+// If the API server rejects the apply request as invalid, e.g. because of a change in an immutable field, applyCr
+// falls back to the UpdateCR hook, that knows how to handle such cases.
+func (h *GenericOperand) applyCr(req *common.HcoRequest, found client.Object, cr client.Object) (client.Object, bool, bool, error) {
+	applyObj, err := h.toApplyConfiguration(cr)
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	applyHash, err := hashApplyConfiguration(applyObj)
+	if err != nil {
+		return nil, false, false, err
+	}
+
+	if h.isAlreadyApplied(found, applyObj, applyHash) {
+		return found, false, false, nil
+	}
+
+	if err = h.upgradeManagedFields(req, found); err != nil {
+		return nil, false, false, err
+	}
+
+	err = h.Patch(req.Ctx, applyObj, client.Apply, client.FieldOwner(hcoutil.HCOFieldManager), client.ForceOwnership)
+	if err != nil {
+		delete(h.lastApplied, found.GetUID())
+		if apierrors.IsInvalid(err) {
+			req.Logger.Info("failed to server-side apply "+h.crType+"; falling back to update", "name", cr.GetName(), "error", err.Error())
+			updated, overwritten, err := h.hooks.UpdateCR(req, h.Client, found, cr)
+			return found, updated, overwritten, err
+		}
+		return nil, false, false, err
+	}
+
+	if h.lastApplied == nil {
+		h.lastApplied = make(map[types.UID]string)
+	}
+	h.lastApplied[found.GetUID()] = applyHash
+
+	if applyObj.GetResourceVersion() == found.GetResourceVersion() {
+		return found, false, false, nil
+	}
+
+	// the patched object, as returned by the API server
+	applied := h.hooks.GetEmptyCr()
+	if err = runtime.DefaultUnstructuredConverter.FromUnstructured(applyObj.UnstructuredContent(), applied); err != nil {
+		return nil, false, false, err
+	}
+
+	if req.HCOTriggered {
+		req.Logger.Info("Updated existing "+h.crType+" to new opinionated values", "name", cr.GetName())
+	} else {
+		req.Logger.Info("Reconciled an externally updated "+h.crType+" to its opinionated values", "name", cr.GetName())
+	}
+
+	return applied, true, !req.HCOTriggered, nil
+}
+
+// toApplyConfiguration converts the required resource to an unstructured object, holding only the fields that HCO
+// wants to own.
+func (h *GenericOperand) toApplyConfiguration(cr client.Object) (*unstructured.Unstructured, error) {
+	gvk, err := apiutil.GVKForObject(cr, h.Scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cr)
+	if err != nil {
+		return nil, err
+	}
+
+	applyObj := &unstructured.Unstructured{Object: u}
+	applyObj.SetGroupVersionKind(gvk)
+	applyObj.SetResourceVersion("")
+	applyObj.SetManagedFields(nil)
+	unstructured.RemoveNestedField(applyObj.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(applyObj.Object, "status")
+
+	return applyObj, nil
+}
+
+// upgradeManagedFields moves the ownership of the fields HCO wrote with update requests, before server-side apply was
+// enabled, to the apply entry of the HCO field manager. Otherwise, these fields stay owned by the update entry, and
+// the API server never prunes them when HCO stops setting them. The update requests of HCO use the same field manager
+// name, as it is derived from the name of the operator binary.
+func (h *GenericOperand) upgradeManagedFields(req *common.HcoRequest, found client.Object) error {
+	patch, err := csaupgrade.UpgradeManagedFieldsPatch(found, sets.New(hcoutil.HCOFieldManager), hcoutil.HCOFieldManager)
+	if err != nil || patch == nil {
+		return err
+	}
+
+	req.Logger.Info("migrating the fields managed by HCO in "+h.crType+" to server-side apply", "name", found.GetName())
+	return h.Patch(req.Ctx, found, client.RawPatch(types.JSONPatchType, patch))
+}
+
+// isAlreadyApplied returns true if the same required resource was already applied to the existing resource, and the
+// existing resource still holds all the fields HCO sets. A modified required resource is always applied again, so
+// that the API server prunes the fields HCO doesn't set anymore.
+func (h *GenericOperand) isAlreadyApplied(found client.Object, applyObj *unstructured.Unstructured, applyHash string) bool {
+	if lastHash, ok := h.lastApplied[found.GetUID()]; !ok || lastHash != applyHash {
+		return false
+	}
+
+	foundObj, err := runtime.DefaultUnstructuredConverter.ToUnstructured(found)
+	if err != nil {
+		return false
+	}
+
+	for field, value := range applyObj.Object {
+		// the type meta is not always set in the objects read from the cache
+		if field == "apiVersion" || field == "kind" {
+			continue
+		}
+		if !isSubset(value, foundObj[field]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isSubset returns true if all the fields that are set in required, are set to the same values in found. Fields that
+// are only set in found, e.g. by defaulting or by other controllers, are ignored.
+func isSubset(required, found any) bool {
+	switch req := required.(type) {
+	case nil:
+		return found == nil
+	case map[string]any:
+		foundMap, ok := found.(map[string]any)
+		if !ok {
+			return false
+		}
+		for field, value := range req {
+			if !isSubset(value, foundMap[field]) {
+				return false
+			}
+		}
+		return true
+	case []any:
+		foundSlice, ok := found.([]any)
+		if !ok || len(foundSlice) != len(req) {
+			return false
+		}
+		for i := range req {
+			if !isSubset(req[i], foundSlice[i]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return equality.Semantic.DeepEqual(required, found)
+	}
+}
+
+func hashApplyConfiguration(applyObj *unstructured.Unstructured) (string, error) {
+	data, err := json.Marshal(applyObj.Object)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(data)), nil
+}
@@ -0,0 +1,400 @@
+package operands
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"slices"
+	"strings"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("Test server-side apply", func() {
+	const cmName = "test-cm"
+
+	var (
+		applyCalls   int
+		applyOptions *client.PatchOptions
+		applyError   error
+	)
+
+	newRequiredCM := func() *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      cmName,
+				Namespace: commontestutils.Namespace,
+				Labels:    map[string]string{hcoutil.AppLabel: hcoutil.HyperConvergedName},
+			},
+			Data: map[string]string{"key": "value"},
+		}
+	}
+
+	// the fake client does not model the managed fields. For objects with managed fields, simulate the ownership of the
+	// ConfigMap data keys: the keys that are owned by the apply entry of the HCO field manager, and are not applied
+	// anymore, are pruned, and the apply entry then owns the applied keys. Like in the API server, the keys that are
+	// owned by the update entry of the HCO field manager are not pruned.
+	simulateDataOwnership := func(obj *unstructured.Unstructured, data []byte) error {
+		applied := &corev1.ConfigMap{}
+		if err := json.Unmarshal(data, applied); err != nil {
+			return err
+		}
+
+		managedFields := obj.GetManagedFields()
+		idx := slices.IndexFunc(managedFields, func(entry metav1.ManagedFieldsEntry) bool {
+			return entry.Manager == hcoutil.HCOFieldManager && entry.Operation == metav1.ManagedFieldsOperationApply
+		})
+
+		if idx >= 0 {
+			owned := map[string]map[string]any{}
+			if err := json.Unmarshal(managedFields[idx].FieldsV1.Raw, &owned); err != nil {
+				return err
+			}
+			for field := range owned["f:data"] {
+				key := strings.TrimPrefix(field, "f:")
+				if _, ok := applied.Data[key]; !ok {
+					unstructured.RemoveNestedField(obj.Object, "data", key)
+				}
+			}
+		} else {
+			managedFields = append(managedFields, metav1.ManagedFieldsEntry{
+				Manager:    hcoutil.HCOFieldManager,
+				Operation:  metav1.ManagedFieldsOperationApply,
+				APIVersion: "v1",
+				FieldsType: "FieldsV1",
+			})
+			idx = len(managedFields) - 1
+		}
+
+		fields := map[string]map[string]any{"f:data": {}}
+		for key := range applied.Data {
+			fields["f:data"]["f:"+key] = map[string]any{}
+		}
+		raw, err := json.Marshal(fields)
+		if err != nil {
+			return err
+		}
+		managedFields[idx].FieldsV1 = &metav1.FieldsV1{Raw: raw}
+		obj.SetManagedFields(managedFields)
+
+		return nil
+	}
+
+	// the fake client does not support apply patches; simulate them with a merge patch, that keeps the fields set by
+	// others, and don't modify the object if nothing was changed.
+	simulateApply := func(ctx context.Context, c client.WithWatch, obj client.Object, patch client.Patch, opts ...client.PatchOption) error {
+		if patch.Type() != types.ApplyPatchType {
+			return c.Patch(ctx, obj, patch, opts...)
+		}
+
+		applyCalls++
+		applyOptions = (&client.PatchOptions{}).ApplyOptions(opts)
+		if applyError != nil {
+			return applyError
+		}
+
+		data, err := patch.Data(obj)
+		if err != nil {
+			return err
+		}
+
+		current := &unstructured.Unstructured{}
+		current.SetGroupVersionKind(obj.GetObjectKind().GroupVersionKind())
+		if err = c.Get(ctx, client.ObjectKeyFromObject(obj), current); err != nil {
+			return err
+		}
+
+		currentJSON, err := json.Marshal(current.Object)
+		if err != nil {
+			return err
+		}
+
+		base := current.DeepCopy()
+		if len(base.GetManagedFields()) > 0 {
+			if err = simulateDataOwnership(base, data); err != nil {
+				return err
+			}
+		}
+
+		baseJSON, err := json.Marshal(base.Object)
+		if err != nil {
+			return err
+		}
+
+		mergedJSON, err := jsonpatch.MergePatch(baseJSON, data)
+		if err != nil {
+			return err
+		}
+
+		merged := map[string]any{}
+		if err = json.Unmarshal(mergedJSON, &merged); err != nil {
+			return err
+		}
+
+		if reflect.DeepEqual(merged, current.Object) {
+			return c.Get(ctx, client.ObjectKeyFromObject(obj), obj)
+		}
+
+		mergePatch, err := jsonpatch.CreateMergePatch(currentJSON, mergedJSON)
+		if err != nil {
+			return err
+		}
+
+		return c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, mergePatch))
+	}
+
+	initClient := func(objs ...client.Object) client.Client {
+		return fake.NewClientBuilder().
+			WithScheme(commontestutils.GetScheme()).
+			WithObjects(objs...).
+			WithInterceptorFuncs(interceptor.Funcs{Patch: simulateApply}).
+			Build()
+	}
+
+	BeforeEach(func() {
+		applyCalls = 0
+		applyOptions = nil
+		applyError = nil
+	})
+
+	Context("server-side apply is enabled", func() {
+		var req *common.HcoRequest
+
+		BeforeEach(func() {
+			hco := commontestutils.NewHco()
+			hco.Annotations = map[string]string{common.ServerSideApplyAnnotationName: "true"}
+			req = commontestutils.NewReq(hco)
+		})
+
+		It("should apply with the HCO field manager, and force the ownership", func() {
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			cl := initClient(existing)
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeFalse())
+
+			Expect(applyCalls).To(Equal(1))
+			Expect(applyOptions.FieldManager).To(Equal(hcoutil.HCOFieldManager))
+			Expect(applyOptions.Force).To(HaveValue(BeTrue()))
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+			Expect(found.Data).To(HaveKeyWithValue("key", "value"))
+		})
+
+		It("should report an out-of-band modification as overwritten", func() {
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			cl := initClient(existing)
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			req.HCOTriggered = false
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(res.Overwritten).To(BeTrue())
+		})
+
+		It("should report the drift against the applied object, when the cache is stale", func() {
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			// simulate a stale cache, that returns the modified object also after HCO applied it
+			staleGet := func(_ context.Context, _ client.WithWatch, _ client.ObjectKey, obj client.Object, _ ...client.GetOption) error {
+				existing.DeepCopyInto(obj.(*corev1.ConfigMap))
+				return nil
+			}
+
+			cl := fake.NewClientBuilder().
+				WithScheme(commontestutils.GetScheme()).
+				WithObjects(existing.DeepCopy()).
+				WithInterceptorFuncs(interceptor.Funcs{Get: staleGet, Patch: simulateApply}).
+				Build()
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			req.HCOTriggered = false
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Overwritten).To(BeTrue())
+
+			Expect(req.DriftRecords).To(HaveLen(1))
+			Expect(req.DriftRecords[0].Changes).To(Equal([]common.FieldDrift{
+				{Path: "/data/key", Op: "replace", From: "modified", To: "value"},
+			}))
+		})
+
+		It("should not update, if only fields that are not managed by HCO were added", func() {
+			existing := newRequiredCM()
+			existing.Labels["other-label"] = "other-value"
+			existing.Annotations = map[string]string{"other-annotation": "other-value"}
+
+			cl := initClient(existing)
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			req.HCOTriggered = false
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(res.Overwritten).To(BeFalse())
+			Expect(applyCalls).To(Equal(1))
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+			Expect(found.Labels).To(HaveKeyWithValue("other-label", "other-value"))
+			Expect(found.Annotations).To(HaveKeyWithValue("other-annotation", "other-value"))
+		})
+
+		It("should not apply again, if the required resource was already applied, and was not modified", func() {
+			cl := initClient(newRequiredCM())
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+			Expect(applyCalls).To(Equal(1))
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeFalse())
+			Expect(applyCalls).To(Equal(1))
+		})
+
+		It("should apply again, if the existing resource was modified", func() {
+			existing := newRequiredCM()
+			cl := initClient(existing)
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+			Expect(applyCalls).To(Equal(1))
+
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existing), existing)).To(Succeed())
+			existing.Data["key"] = "modified"
+			Expect(cl.Update(context.TODO(), existing)).To(Succeed())
+
+			req.HCOTriggered = false
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Overwritten).To(BeTrue())
+			Expect(applyCalls).To(Equal(2))
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+			Expect(found.Data).To(HaveKeyWithValue("key", "value"))
+		})
+
+		It("should apply again, if the required resource was modified", func() {
+			required := newRequiredCM()
+			cl := initClient(newRequiredCM())
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), required)
+
+			Expect(handler.Ensure(req).Err).ToNot(HaveOccurred())
+			Expect(applyCalls).To(Equal(1))
+
+			required.Data["key"] = "new-value"
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(applyCalls).To(Equal(2))
+		})
+
+		It("should migrate the fields HCO wrote with update requests, and prune them when HCO stops setting them", func() {
+			existing := newRequiredCM()
+			existing.Data["old-key"] = "old-value"
+			existing.ManagedFields = []metav1.ManagedFieldsEntry{
+				{
+					Manager:    hcoutil.HCOFieldManager,
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:data":{"f:key":{},"f:old-key":{}}}`)},
+				},
+				{
+					Manager:    "other-manager",
+					Operation:  metav1.ManagedFieldsOperationUpdate,
+					APIVersion: "v1",
+					FieldsType: "FieldsV1",
+					FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{}}}`)},
+				},
+			}
+
+			cl := initClient(existing)
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+			Expect(found.Data).To(Equal(map[string]string{"key": "value"}))
+
+			managers := make([]string, 0, len(found.ManagedFields))
+			for _, entry := range found.ManagedFields {
+				managers = append(managers, entry.Manager+"/"+string(entry.Operation))
+			}
+			Expect(managers).To(ConsistOf(hcoutil.HCOFieldManager+"/Apply", "other-manager/Update"))
+		})
+
+		It("should fall back to the UpdateCR hook if the apply request is invalid", func() {
+			applyError = apierrors.NewInvalid(schema.GroupKind{Kind: "ConfigMap"}, cmName, field.ErrorList{field.Invalid(field.NewPath("data"), "", "immutable")})
+
+			existing := newRequiredCM()
+			existing.Data["key"] = "modified"
+
+			cl := initClient(existing)
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).ToNot(HaveOccurred())
+			Expect(res.Updated).To(BeTrue())
+			Expect(applyCalls).To(Equal(1))
+
+			found := &corev1.ConfigMap{}
+			Expect(cl.Get(context.TODO(), client.ObjectKeyFromObject(existing), found)).To(Succeed())
+			Expect(found.Data).To(HaveKeyWithValue("key", "value"))
+		})
+
+		It("should return other apply errors", func() {
+			applyError = apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, cmName, nil)
+
+			cl := initClient(newRequiredCM())
+			handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+			res := handler.Ensure(req)
+			Expect(res.Err).To(MatchError(apierrors.IsForbidden, "forbidden error"))
+		})
+	})
+
+	It("should not use server-side apply, if it is not enabled", func() {
+		existing := newRequiredCM()
+		existing.Data["key"] = "modified"
+
+		cl := initClient(existing)
+		handler := NewCmHandler(cl, commontestutils.GetScheme(), newRequiredCM())
+
+		res := handler.Ensure(commontestutils.NewReq(commontestutils.NewHco()))
+		Expect(res.Err).ToNot(HaveOccurred())
+		Expect(res.Updated).To(BeTrue())
+		Expect(applyCalls).To(BeZero())
+	})
+})
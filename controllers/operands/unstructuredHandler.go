@@ -0,0 +1,202 @@
+package operands
+
+import (
+	"errors"
+	"reflect"
+	"slices"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+// NewUnstructuredHandler returns a handler for an object of any kind, given as an unstructured manifest. The fields
+// in the manifest are reconciled; other fields, e.g. the ones defaulted by the API server, are kept as is.
+//
+// If aggregateStatus is true, the Available, Progressing and Degraded conditions of the object are aggregated into
+// the HyperConverged conditions, as for the built-in operands.
+func NewUnstructuredHandler(Client client.Client, Scheme *runtime.Scheme, required *unstructured.Unstructured, aggregateStatus bool) Operand {
+	var hooks HCOResourceHooks = &unstructuredHooks{required: required, scheme: Scheme}
+	if aggregateStatus {
+		hooks = &unstructuredOperandHooks{unstructuredHooks: unstructuredHooks{required: required, scheme: Scheme}}
+	}
+
+	return &unstructuredOperand{
+		GenericOperand: NewGenericOperand(Client, Scheme, required.GetKind(), hooks, false),
+		kind:           required.GetKind(),
+	}
+}
+
+type unstructuredOperand struct {
+	*GenericOperand
+	kind string
+}
+
+func (h *unstructuredOperand) Ensure(req *common.HcoRequest) *EnsureResult {
+	res := h.GenericOperand.Ensure(req)
+	// the type of unstructured objects is not their kind
+	res.Type = h.kind
+	return res
+}
+
+type unstructuredHooks struct {
+	required *unstructured.Unstructured
+	scheme   *runtime.Scheme
+}
+
+func (h unstructuredHooks) GetFullCr(hc *hcov1beta1.HyperConverged) (client.Object, error) {
+	cr := h.required.DeepCopy()
+
+	// the owner must be in the same namespace
+	if cr.GetNamespace() == hc.Namespace {
+		if err := controllerutil.SetControllerReference(hc, cr, h.scheme); err != nil {
+			return nil, err
+		}
+	}
+
+	return cr, nil
+}
+
+func (h unstructuredHooks) GetEmptyCr() client.Object {
+	cr := &unstructured.Unstructured{}
+	cr.SetGroupVersionKind(h.required.GroupVersionKind())
+	return cr
+}
+
+func (unstructuredHooks) JustBeforeComplete(_ *common.HcoRequest) { /* no implementation */ }
+
+func (unstructuredHooks) UpdateCR(req *common.HcoRequest, Client client.Client, exists runtime.Object, required runtime.Object) (bool, bool, error) {
+	found, ok1 := exists.(*unstructured.Unstructured)
+	cr, ok2 := required.(*unstructured.Unstructured)
+	if !ok1 || !ok2 {
+		return false, false, errors.New("can't convert to Unstructured")
+	}
+
+	if !mergeUnstructured(cr, found) {
+		return false, false, nil
+	}
+
+	if req.HCOTriggered {
+		req.Logger.Info("Updating existing "+cr.GetKind()+" to new opinionated values", "name", cr.GetName())
+	} else {
+		req.Logger.Info("Reconciling an externally updated "+cr.GetKind()+" to its opinionated values", "name", cr.GetName())
+	}
+
+	if err := Client.Update(req.Ctx, found); err != nil {
+		return false, false, err
+	}
+
+	return true, !req.HCOTriggered, nil
+}
+
+// mergeUnstructured sets the fields of the required object, that are missing or different in the found object. Maps,
+// including the labels and the annotations, are merged, so fields that are not in the required object are kept; any
+// other value, including lists, is replaced. Returns true if the found object was modified.
+func mergeUnstructured(required, found *unstructured.Unstructured) bool {
+	changed := false
+
+	ownerRefs := found.GetOwnerReferences()
+	for _, ref := range required.GetOwnerReferences() {
+		if !slices.ContainsFunc(ownerRefs, func(existing metav1.OwnerReference) bool { return existing.UID == ref.UID }) {
+			ownerRefs = append(ownerRefs, ref)
+			found.SetOwnerReferences(ownerRefs)
+			changed = true
+		}
+	}
+
+	for _, field := range []string{"labels", "annotations"} {
+		value, ok, _ := unstructured.NestedFieldNoCopy(required.Object, "metadata", field)
+		if !ok {
+			continue
+		}
+
+		foundValue, _, _ := unstructured.NestedFieldNoCopy(found.Object, "metadata", field)
+		if merged, fieldChanged := mergeValue(value, foundValue); fieldChanged {
+			_ = unstructured.SetNestedField(found.Object, merged, "metadata", field)
+			changed = true
+		}
+	}
+
+	for key, value := range required.Object {
+		switch key {
+		case "apiVersion", "kind", "metadata", "status":
+			continue
+		}
+
+		if merged, fieldChanged := mergeValue(value, found.Object[key]); fieldChanged {
+			found.Object[key] = merged
+			changed = true
+		}
+	}
+
+	return changed
+}
+
+// mergeValue returns the found value, after setting the fields of the required value in it, and true if anything
+// was changed.
+func mergeValue(required, found any) (any, bool) {
+	requiredMap, ok := required.(map[string]any)
+	if !ok {
+		if reflect.DeepEqual(required, found) {
+			return found, false
+		}
+		return runtime.DeepCopyJSONValue(required), true
+	}
+
+	foundMap, ok := found.(map[string]any)
+	if !ok {
+		return runtime.DeepCopyJSONValue(required), true
+	}
+
+	changed := false
+	for key, value := range requiredMap {
+		if merged, fieldChanged := mergeValue(value, foundMap[key]); fieldChanged {
+			foundMap[key] = merged
+			changed = true
+		}
+	}
+
+	return foundMap, changed
+}
+
+type unstructuredOperandHooks struct {
+	unstructuredHooks
+}
+
+func (unstructuredOperandHooks) GetConditions(cr runtime.Object) []metav1.Condition {
+	u, ok := cr.(*unstructured.Unstructured)
+	if !ok {
+		return nil
+	}
+
+	rawConditions, found, err := unstructured.NestedSlice(u.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	conditions := make([]metav1.Condition, 0, len(rawConditions))
+	for _, rawCondition := range rawConditions {
+		condMap, ok := rawCondition.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		cond := metav1.Condition{}
+		if err = runtime.DefaultUnstructuredConverter.FromUnstructured(condMap, &cond); err != nil {
+			continue
+		}
+		conditions = append(conditions, cond)
+	}
+
+	return conditions
+}
+
+// CheckComponentVersion always returns true, as there is no known version for the extension objects
+func (unstructuredOperandHooks) CheckComponentVersion(_ runtime.Object) bool {
+	return true
+}
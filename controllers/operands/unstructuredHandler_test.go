@@ -0,0 +1,41 @@
+package operands
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Test unstructured handler", func() {
+	DescribeTable("mergeValue should set the required fields, and keep the others", func(required, found, expected any, expectedChanged bool) {
+		merged, changed := mergeValue(required, found)
+		Expect(changed).To(Equal(expectedChanged))
+		Expect(merged).To(Equal(expected))
+	},
+		Entry("equal scalars", "a", "a", "a", false),
+		Entry("different scalars", int64(1), int64(2), int64(1), true),
+		Entry("missing field",
+			map[string]any{"a": "b"},
+			nil,
+			map[string]any{"a": "b"},
+			true,
+		),
+		Entry("additional fields are kept",
+			map[string]any{"a": "b"},
+			map[string]any{"a": "b", "c": "d"},
+			map[string]any{"a": "b", "c": "d"},
+			false,
+		),
+		Entry("nested field is modified",
+			map[string]any{"a": map[string]any{"b": "c"}},
+			map[string]any{"a": map[string]any{"b": "x", "d": "e"}},
+			map[string]any{"a": map[string]any{"b": "c", "d": "e"}},
+			true,
+		),
+		Entry("lists are replaced",
+			map[string]any{"a": []any{"b"}},
+			map[string]any{"a": []any{"b", "c"}},
+			map[string]any{"a": []any{"b"}},
+			true,
+		),
+	)
+})
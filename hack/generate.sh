@@ -7,7 +7,7 @@ PROJECT_ROOT="$(readlink -e "$(dirname "${BASH_SOURCE[0]}")"/../)"
 
 PACKAGE=github.com/kubevirt/hyperconverged-cluster-operator
 API_FOLDER=api
-API_VERSION=v1beta1
+API_VERSIONS="v1beta1 v1"
 
 go install \
 	k8s.io/code-generator/cmd/deepcopy-gen@${K8S_VER} \
@@ -16,23 +16,25 @@ go install \
 go install \
 	k8s.io/kube-openapi/cmd/openapi-gen@${KUBEOPENAPI_VER}
 
-deepcopy-gen \
-	--output-file zz_generated.deepcopy.go \
-	--go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
-	"${PACKAGE}/${API_FOLDER}/${API_VERSION}"
-
-defaulter-gen \
-	--output-file zz_generated.defaults.go \
-	--go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
-	"${PACKAGE}/${API_FOLDER}/${API_VERSION}"
-
-openapi-gen \
-	--output-file zz_generated.openapi.go \
-	--go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
-	--output-dir api/v1beta1/ \
-	--output-pkg github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1 \
-	"${PACKAGE}/${API_FOLDER}/${API_VERSION}"
-
-go fmt api/v1beta1/zz_generated.deepcopy.go
-go fmt api/v1beta1/zz_generated.defaults.go
-go fmt api/v1beta1/zz_generated.openapi.go
+for API_VERSION in ${API_VERSIONS}; do
+	deepcopy-gen \
+		--output-file zz_generated.deepcopy.go \
+		--go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
+		"${PACKAGE}/${API_FOLDER}/${API_VERSION}"
+
+	defaulter-gen \
+		--output-file zz_generated.defaults.go \
+		--go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
+		"${PACKAGE}/${API_FOLDER}/${API_VERSION}"
+
+	openapi-gen \
+		--output-file zz_generated.openapi.go \
+		--go-header-file "${PROJECT_ROOT}/hack/boilerplate.go.txt" \
+		--output-dir ${API_FOLDER}/${API_VERSION}/ \
+		--output-pkg ${PACKAGE}/${API_FOLDER}/${API_VERSION} \
+		"${PACKAGE}/${API_FOLDER}/${API_VERSION}"
+
+	go fmt ${API_FOLDER}/${API_VERSION}/zz_generated.deepcopy.go
+	go fmt ${API_FOLDER}/${API_VERSION}/zz_generated.defaults.go
+	go fmt ${API_FOLDER}/${API_VERSION}/zz_generated.openapi.go
+done
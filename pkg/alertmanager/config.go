@@ -0,0 +1,66 @@
+package alertmanager
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+const requestTimeout = 30 * time.Second
+
+// Config is the connection configuration of an Alertmanager
+type Config struct {
+	// Host is the base URL of the Alertmanager API; e.g. "https://alertmanager.monitoring.svc:9093"
+	Host string
+	// BearerToken authenticates the requests with a bearer token, if not empty
+	BearerToken string
+	// Username and Password authenticate the requests with basic authentication, if Username is not empty. Basic
+	// authentication takes precedence over the bearer token.
+	Username string
+	Password string
+	// RootCAs verify the Alertmanager certificate. The system CAs are used if nil.
+	RootCAs *x509.CertPool
+	// ClientCertificate is presented to Alertmanager for mutual TLS, if not nil
+	ClientCertificate *tls.Certificate
+	// ServerName is the expected server name of the Alertmanager certificate, if not empty
+	ServerName string
+}
+
+func (cfg Config) validate() error {
+	u, err := url.Parse(cfg.Host)
+	if err != nil {
+		return fmt.Errorf("invalid alertmanager host %q: %w", cfg.Host, err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid alertmanager host %q: must be an http or https URL", cfg.Host)
+	}
+
+	return nil
+}
+
+func (cfg Config) tlsConfig() *tls.Config {
+	tlsConfig := &tls.Config{
+		RootCAs:    cfg.RootCAs,
+		ServerName: cfg.ServerName,
+		MinVersion: tls.VersionTLS12,
+	}
+
+	if cfg.ClientCertificate != nil {
+		tlsConfig.Certificates = []tls.Certificate{*cfg.ClientCertificate}
+	}
+
+	return tlsConfig
+}
+
+func (cfg Config) authorize(req *http.Request) {
+	switch {
+	case cfg.Username != "":
+		req.SetBasicAuth(cfg.Username, cfg.Password)
+	case cfg.BearerToken != "":
+		req.Header.Add("Authorization", "Bearer "+cfg.BearerToken)
+	}
+}
@@ -0,0 +1,158 @@
+package alertmanager_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/alertmanager"
+)
+
+var _ = Describe("Config", func() {
+	var handler http.HandlerFunc
+
+	listSilences := func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte(listResp))
+	}
+
+	BeforeEach(func() {
+		handler = listSilences
+	})
+
+	It("should authenticate with basic authentication", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if username, password, ok := r.BasicAuth(); !ok || username != "user" || password != "pass" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			listSilences(w, r)
+		}
+		ts := httptest.NewServer(handler)
+		DeferCleanup(ts.Close)
+
+		api, err := alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL, Username: "user", Password: "pass"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.ListSilences()).To(HaveLen(1))
+
+		api, err = alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL, Username: "user", Password: "wrong"})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = api.ListSilences()
+		Expect(err).To(MatchError(ContainSubstring("401 Unauthorized")))
+		Expect(err).To(MatchError(alertmanager.ErrUnauthorized))
+		Expect(err).ToNot(MatchError(alertmanager.ErrUnavailable))
+	})
+
+	It("should authenticate with a bearer token", func() {
+		handler = func(w http.ResponseWriter, r *http.Request) {
+			if r.Header.Get("Authorization") != "Bearer token" {
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			listSilences(w, r)
+		}
+		ts := httptest.NewServer(handler)
+		DeferCleanup(ts.Close)
+
+		api, err := alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL + "/", BearerToken: "token"})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.ListSilences()).To(HaveLen(1))
+	})
+
+	It("should verify the server certificate with the CA bundle, and present the client certificate", func() {
+		clientCert, clientCertPool := newSelfSignedCertificate()
+
+		ts := httptest.NewUnstartedServer(handler)
+		ts.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: clientCertPool}
+		ts.StartTLS()
+		DeferCleanup(ts.Close)
+
+		serverCAs := x509.NewCertPool()
+		serverCAs.AddCert(ts.Certificate())
+
+		api, err := alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL, RootCAs: serverCAs, ClientCertificate: &clientCert})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.ListSilences()).To(HaveLen(1))
+
+		By("without the client certificate")
+		api, err = alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL, RootCAs: serverCAs})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = api.ListSilences()
+		Expect(err).To(HaveOccurred())
+
+		By("without the CA bundle")
+		api, err = alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL, ClientCertificate: &clientCert})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = api.ListSilences()
+		Expect(err).To(MatchError(ContainSubstring("certificate")))
+	})
+
+	It("should report an unreachable alertmanager as unavailable", func() {
+		ts := httptest.NewServer(handler)
+		ts.Close()
+
+		api, err := alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL})
+		Expect(err).ToNot(HaveOccurred())
+		_, err = api.ListSilences()
+		Expect(err).To(MatchError(alertmanager.ErrUnavailable))
+	})
+
+	It("should report a not ready alertmanager as unavailable", func() {
+		ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		DeferCleanup(ts.Close)
+
+		api, err := alertmanager.NewAPIFromConfig(alertmanager.Config{Host: ts.URL})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(api.CreateSilence(alertmanager.Silence{})).To(MatchError(alertmanager.ErrUnavailable))
+	})
+
+	DescribeTable("should reject an invalid host", func(host string) {
+		_, err := alertmanager.NewAPIFromConfig(alertmanager.Config{Host: host})
+		Expect(err).To(MatchError(ContainSubstring("invalid alertmanager host")))
+	},
+		Entry("empty host", ""),
+		Entry("no scheme", "alertmanager.monitoring.svc:9093"),
+		Entry("unsupported scheme", "ftp://alertmanager.monitoring.svc:9093"),
+	)
+})
+
+// newSelfSignedCertificate returns a self-signed client certificate, and a pool with this certificate
+func newSelfSignedCertificate() (tls.Certificate, *x509.CertPool) {
+	GinkgoHelper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).ToNot(HaveOccurred())
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "hyperconverged-cluster-operator"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).ToNot(HaveOccurred())
+
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).ToNot(HaveOccurred())
+
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: cert}, pool
+}
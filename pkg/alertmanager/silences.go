@@ -3,18 +3,26 @@ package alertmanager
 import (
 	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
 var log = logf.Log.WithName("alertmanager")
 
+// ErrUnavailable is returned when Alertmanager can't be reached, or can't handle the request at the moment
+var ErrUnavailable = errors.New("alertmanager is not available")
+
+// ErrUnauthorized is returned when Alertmanager rejects the credentials of the client
+var ErrUnauthorized = errors.New("alertmanager rejected the credentials")
+
 type Api struct {
 	httpClient http.Client
 	host       string
-	token      string
+	authorize  func(req *http.Request)
 }
 
 type Silence struct {
@@ -38,12 +46,34 @@ type Matcher struct {
 	Value   string `json:"value"`
 }
 
+// NewAPI returns a client of the Alertmanager API, that authenticates with a bearer token
 func NewAPI(httpClient http.Client, host string, token string) *Api {
 	return &Api{
 		httpClient: httpClient,
 		host:       host,
-		token:      token,
+		authorize: func(req *http.Request) {
+			req.Header.Add("Authorization", "Bearer "+token)
+		},
+	}
+}
+
+// NewAPIFromConfig returns a client of the Alertmanager API, with the connection configuration
+func NewAPIFromConfig(cfg Config) (*Api, error) {
+	if err := cfg.validate(); err != nil {
+		return nil, err
 	}
+
+	return &Api{
+		httpClient: http.Client{
+			Transport: &http.Transport{
+				Proxy:           http.ProxyFromEnvironment,
+				TLSClientConfig: cfg.tlsConfig(),
+			},
+			Timeout: requestTimeout,
+		},
+		host:      strings.TrimSuffix(cfg.Host, "/"),
+		authorize: cfg.authorize,
+	}, nil
 }
 
 func (api *Api) ListSilences() ([]Silence, error) {
@@ -52,9 +82,9 @@ func (api *Api) ListSilences() ([]Silence, error) {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	api.authorize(req)
 
-	resp, err := api.httpClient.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
 		return nil, fmt.Errorf("failed to list silences: %w", err)
 	}
@@ -62,7 +92,7 @@ func (api *Api) ListSilences() ([]Silence, error) {
 
 	if resp.StatusCode != http.StatusOK {
 		log.V(1).Info("list silences http request", "req", req, "resp", resp)
-		return nil, fmt.Errorf("failed to list silences: %s", resp.Status)
+		return nil, fmt.Errorf("failed to list silences: %w", statusError(resp))
 	}
 
 	var amSilences []Silence
@@ -74,7 +104,34 @@ func (api *Api) ListSilences() ([]Silence, error) {
 	return amSilences, nil
 }
 
+// CreateSilence creates a new silence. The ID of the silence must be empty.
 func (api *Api) CreateSilence(s Silence) error {
+	if s.ID != "" {
+		return fmt.Errorf("failed to create silence: the silence ID must be empty")
+	}
+
+	if err := api.postSilence(s); err != nil {
+		return fmt.Errorf("failed to create silence: %w", err)
+	}
+
+	return nil
+}
+
+// UpdateSilence updates the existing silence with the ID of s. If the silence can't be updated in place, e.g. because
+// its matchers were modified, Alertmanager expires it and creates a new silence instead.
+func (api *Api) UpdateSilence(s Silence) error {
+	if s.ID == "" {
+		return fmt.Errorf("failed to update silence: missing silence ID")
+	}
+
+	if err := api.postSilence(s); err != nil {
+		return fmt.Errorf("failed to update silence %s: %w", s.ID, err)
+	}
+
+	return nil
+}
+
+func (api *Api) postSilence(s Silence) error {
 	body, err := json.Marshal(s)
 	if err != nil {
 		return fmt.Errorf("failed to marshal silence: %w", err)
@@ -85,35 +142,65 @@ func (api *Api) CreateSilence(s Silence) error {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	api.authorize(req)
 	req.Header.Add("Content-Type", "application/json")
 
-	resp, err := api.httpClient.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to create silence: %w", err)
+		return err
 	}
+	defer resp.Body.Close()
+
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to create silence: %s", resp.Status)
+		return statusError(resp)
 	}
 
 	return nil
 }
 
-func (api *Api) DeleteSilence(id string) error {
+// ExpireSilence expires the silence with the given ID. Alertmanager keeps the expired silence for a while, with the
+// "expired" state, before removing it.
+func (api *Api) ExpireSilence(id string) error {
 	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v2/silence/%s", api.host, id), nil)
 	if err != nil {
 		return fmt.Errorf("failed to create request: %w", err)
 	}
 
-	req.Header.Add("Authorization", "Bearer "+api.token)
+	api.authorize(req)
 
-	resp, err := api.httpClient.Do(req)
+	resp, err := api.do(req)
 	if err != nil {
-		return fmt.Errorf("failed to delete silence: %w", err)
+		return fmt.Errorf("failed to expire silence: %w", err)
 	}
+	defer resp.Body.Close()
+
 	if resp.StatusCode != http.StatusOK {
-		return fmt.Errorf("failed to delete silence: %s", resp.Status)
+		return fmt.Errorf("failed to expire silence: %w", statusError(resp))
 	}
 
 	return nil
 }
+
+// do sends the request. Connection failures are reported as ErrUnavailable.
+func (api *Api) do(req *http.Request) (*http.Response, error) {
+	resp, err := api.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrUnavailable, err)
+	}
+
+	return resp, nil
+}
+
+// statusError returns the error of a failed request. The responses of a gateway that can't reach Alertmanager, and of
+// an Alertmanager that is not ready, are reported as ErrUnavailable. Rejected credentials are reported as
+// ErrUnauthorized.
+func statusError(resp *http.Response) error {
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return fmt.Errorf("%w: %s", ErrUnavailable, resp.Status)
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return fmt.Errorf("%w: %s", ErrUnauthorized, resp.Status)
+	default:
+		return errors.New(resp.Status)
+	}
+}
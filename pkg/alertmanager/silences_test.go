@@ -62,8 +62,23 @@ var _ = Describe("Silences", func() {
 		Expect(err).ToNot(HaveOccurred())
 	})
 
+	It("should fail to POST /api/v2/silences for a new silence with an ID", func() {
+		err := api.CreateSilence(alertmanager.Silence{ID: "bb881d7f-3278-46fd-a638-d42c57f235b6"})
+		Expect(err).To(MatchError(ContainSubstring("the silence ID must be empty")))
+	})
+
+	It("should successfully POST /api/v2/silences to update a silence", func() {
+		err := api.UpdateSilence(alertmanager.Silence{ID: "bb881d7f-3278-46fd-a638-d42c57f235b6"})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("should fail to update a silence without an ID", func() {
+		err := api.UpdateSilence(alertmanager.Silence{})
+		Expect(err).To(MatchError(ContainSubstring("missing silence ID")))
+	})
+
 	It("should successfully DELETE /api/v2/silences/{id}", func() {
-		err := api.DeleteSilence("bb881d7f-3278-46fd-a638-d42c57f235b6")
+		err := api.ExpireSilence("bb881d7f-3278-46fd-a638-d42c57f235b6")
 		Expect(err).ToNot(HaveOccurred())
 	})
 })
@@ -591,7 +591,7 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 		},
 		{
 			APIGroups: stringListToSlice("admissionregistration.k8s.io"),
-			Resources: stringListToSlice("validatingwebhookconfigurations"),
+			Resources: stringListToSlice("validatingwebhookconfigurations", "mutatingwebhookconfigurations"),
 			Verbs:     stringListToSlice("list", "watch", "update", "patch"),
 		},
 		roleWithAllPermissions("console.openshift.io", stringListToSlice("consoleclidownloads", "consolequickstarts")),
@@ -669,6 +669,31 @@ func GetClusterPermissions() []rbacv1.PolicyRule {
 			Resources: stringListToSlice("networkpolicies"),
 			Verbs:     stringListToSlice("get", "list", "watch", "create", "update", "delete"),
 		},
+		{
+			APIGroups: stringListToSlice(kvapi.GroupName),
+			Resources: stringListToSlice("virtualmachines", "virtualmachineinstances"),
+			Verbs:     stringListToSlice("list"),
+		},
+		{
+			APIGroups: stringListToSlice(cdiapi.GroupName),
+			Resources: stringListToSlice("datavolumes"),
+			Verbs:     stringListToSlice("list"),
+		},
+		{
+			APIGroups: emptyAPIGroup,
+			Resources: stringListToSlice("persistentvolumeclaims"),
+			Verbs:     stringListToSlice("list"),
+		},
+		{
+			APIGroups: stringListToSlice("authentication.k8s.io"),
+			Resources: stringListToSlice("tokenreviews"),
+			Verbs:     stringListToSlice("create"),
+		},
+		{
+			APIGroups: stringListToSlice("authorization.k8s.io"),
+			Resources: stringListToSlice("subjectaccessreviews"),
+			Verbs:     stringListToSlice("create"),
+		},
 	}
 }
 
@@ -823,7 +848,7 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		DeploymentName:          hcoWhDeploymentName,
 		ContainerPort:           util.WebhookPort,
 		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
-		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNoneOnDryRun),
 		FailurePolicy:           ptr.To(admissionregistrationv1.Fail),
 		TimeoutSeconds:          ptr.To[int32](10),
 		Rules: []admissionregistrationv1.RuleWithOperations{
@@ -870,6 +895,40 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: ptr.To(util.HCONSWebhookPath),
 	}
 
+	// the other namespaces HCO uses; their deletion is not blocked if the webhook is not available
+	mutatingManagedNamespacesWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoMutatingWebhookManagedNS,
+		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNoneOnDryRun),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ObjectSelector: &metav1.LabelSelector{
+			MatchExpressions: []metav1.LabelSelectorRequirement{
+				{
+					Key:      util.KubernetesMetadataName,
+					Operator: metav1.LabelSelectorOpNotIn,
+					Values:   []string{params.Namespace},
+				},
+			},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Delete,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   []string{""},
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("namespaces"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCONSWebhookPath),
+	}
+
 	mutatingHyperConvergedWebhook := csvv1alpha1.WebhookDescription{
 		GenerateName:            util.HcoMutatingWebhookHyperConverged,
 		Type:                    csvv1alpha1.MutatingAdmissionWebhook,
@@ -895,6 +954,78 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 		WebhookPath: ptr.To(util.HCOMutatingWebhookPath),
 	}
 
+	// The operand CRs are reconciled by HCO anyway, so don't block their modifications if the webhook is not available;
+	// so failurePolicy = admissionregistrationv1.Ignore
+	validatingOperandsWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoOperandsValidatingWebhook,
+		Type:                    csvv1alpha1.ValidatingAdmissionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Ignore),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ObjectSelector: &metav1.LabelSelector{
+			MatchLabels: map[string]string{util.AppLabelManagedBy: util.OperatorName},
+		},
+		Rules: []admissionregistrationv1.RuleWithOperations{
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("kubevirt.io"),
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("kubevirts"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("cdi.kubevirt.io"),
+					APIVersions: stringListToSlice("v1beta1"),
+					Resources:   stringListToSlice("cdis"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("networkaddonsoperator.network.kubevirt.io"),
+					APIVersions: stringListToSlice("v1"),
+					Resources:   stringListToSlice("networkaddonsconfigs"),
+				},
+			},
+			{
+				Operations: []admissionregistrationv1.OperationType{
+					admissionregistrationv1.Update,
+				},
+				Rule: admissionregistrationv1.Rule{
+					APIGroups:   stringListToSlice("ssp.kubevirt.io"),
+					APIVersions: stringListToSlice("v1beta3"),
+					Resources:   stringListToSlice("ssps"),
+				},
+			},
+		},
+		WebhookPath: ptr.To(util.HCOOperandsWebhookPath),
+	}
+
+	conversionHyperConvergedWebhook := csvv1alpha1.WebhookDescription{
+		GenerateName:            util.HcoConversionWebhookHyperConverged,
+		Type:                    csvv1alpha1.ConversionWebhook,
+		DeploymentName:          hcoWhDeploymentName,
+		ContainerPort:           util.WebhookPort,
+		AdmissionReviewVersions: stringListToSlice("v1beta1", "v1"),
+		SideEffects:             ptr.To(admissionregistrationv1.SideEffectClassNone),
+		FailurePolicy:           ptr.To(admissionregistrationv1.Fail),
+		TimeoutSeconds:          ptr.To[int32](10),
+		ConversionCRDs:          stringListToSlice("hyperconvergeds.hco.kubevirt.io"),
+		WebhookPath:             ptr.To(util.HCOConvertWebhookPath),
+	}
+
 	return &csvv1alpha1.ClusterServiceVersion{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "operators.coreos.com/v1alpha1",
@@ -998,7 +1129,10 @@ func GetCSVBase(params *CSVBaseParams) *csvv1alpha1.ClusterServiceVersion {
 			WebhookDefinitions: []csvv1alpha1.WebhookDescription{
 				validatingWebhook,
 				mutatingNamespaceWebhook,
+				mutatingManagedNamespacesWebhook,
 				mutatingHyperConvergedWebhook,
+				validatingOperandsWebhook,
+				conversionHyperConvergedWebhook,
 			},
 			CustomResourceDefinitions: csvv1alpha1.CustomResourceDefinitions{
 				Owned: []csvv1alpha1.CRDDescription{
@@ -11,6 +11,8 @@ const (
 	LabelNodeRoleMaster = "node-role.kubernetes.io/master"
 	// LabelNodeRoleWorker is the label used to identify worker nodes
 	LabelNodeRoleWorker = "node-role.kubernetes.io/worker"
+	// LabelNodeRoleInfra is the label used to identify infra nodes
+	LabelNodeRoleInfra = "node-role.kubernetes.io/infra"
 )
 
 var (
@@ -0,0 +1,139 @@
+package nodeinfo
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+)
+
+// NodePlacementProposal is a node placement, proposed from the roles of the cluster nodes, with its rationale. The
+// NodePlacement is nil if there is nothing to propose.
+type NodePlacementProposal struct {
+	NodePlacement *sdkapi.NodePlacement
+	Reason        string
+}
+
+// ProposeNodePlacement proposes the node placement of the infra and of the workloads components, from the roles of the
+// cluster nodes
+func ProposeNodePlacement(ctx context.Context, cl client.Client) (infra NodePlacementProposal, workloads NodePlacementProposal, err error) {
+	nodes, err := getNodes(ctx, cl)
+	if err != nil {
+		return NodePlacementProposal{}, NodePlacementProposal{}, fmt.Errorf("failed to read the cluster nodes; %v", err)
+	}
+
+	infra, workloads = proposeNodePlacement(nodes)
+	return infra, workloads, nil
+}
+
+func proposeNodePlacement(nodes []corev1.Node) (NodePlacementProposal, NodePlacementProposal) {
+	var infraNodes, workerNodes, infraWorkerNodes int
+	infraTaints := map[corev1.TaintEffect]struct{}{}
+
+	for _, node := range nodes {
+		isInfra := isInfraNode(node)
+		isWorker := isWorkerNode(node)
+
+		if isInfra {
+			infraNodes++
+			for _, taint := range node.Spec.Taints {
+				if taint.Key == LabelNodeRoleInfra {
+					infraTaints[taint.Effect] = struct{}{}
+				}
+			}
+		}
+
+		if isWorker {
+			workerNodes++
+			if isInfra {
+				infraWorkerNodes++
+			}
+		}
+	}
+
+	return proposeInfraNodePlacement(infraNodes, infraTaints), proposeWorkloadsNodePlacement(len(nodes), workerNodes, infraWorkerNodes)
+}
+
+func proposeInfraNodePlacement(infraNodes int, infraTaints map[corev1.TaintEffect]struct{}) NodePlacementProposal {
+	if infraNodes == 0 {
+		return NodePlacementProposal{
+			Reason: "there are no nodes with the infra role; the infra components can run on any node",
+		}
+	}
+
+	np := &sdkapi.NodePlacement{
+		NodeSelector: map[string]string{LabelNodeRoleInfra: ""},
+	}
+
+	reason := fmt.Sprintf("%d nodes have the infra role; the infra components are placed on them", infraNodes)
+	if infraNodes == 1 {
+		reason = "1 node has the infra role; the infra components are placed on it"
+	}
+
+	// keep a stable order of the tolerations
+	for _, effect := range []corev1.TaintEffect{corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute} {
+		if _, ok := infraTaints[effect]; ok {
+			np.Tolerations = append(np.Tolerations, corev1.Toleration{
+				Key:      LabelNodeRoleInfra,
+				Operator: corev1.TolerationOpExists,
+				Effect:   effect,
+			})
+		}
+	}
+
+	if len(np.Tolerations) > 0 {
+		reason += ", with tolerations for the " + LabelNodeRoleInfra + " taints"
+	}
+
+	return NodePlacementProposal{NodePlacement: np, Reason: reason}
+}
+
+func proposeWorkloadsNodePlacement(nodes, workerNodes, infraWorkerNodes int) NodePlacementProposal {
+	switch {
+	case workerNodes == 0:
+		return NodePlacementProposal{
+			Reason: "there are no nodes with the worker role; the workloads components can run on any node",
+		}
+
+	case workerNodes == nodes && infraWorkerNodes == 0:
+		return NodePlacementProposal{
+			Reason: "all the nodes have the worker role; the workloads components can run on any node",
+		}
+
+	case infraWorkerNodes > 0 && infraWorkerNodes < workerNodes:
+		return NodePlacementProposal{
+			NodePlacement: &sdkapi.NodePlacement{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+								MatchExpressions: []corev1.NodeSelectorRequirement{
+									{Key: LabelNodeRoleWorker, Operator: corev1.NodeSelectorOpExists},
+									{Key: LabelNodeRoleInfra, Operator: corev1.NodeSelectorOpDoesNotExist},
+								},
+							}},
+						},
+					},
+				},
+			},
+			Reason: fmt.Sprintf("%d of the %d nodes have the worker role, and %d of them also have the infra role; the workloads components are placed on the other worker nodes",
+				workerNodes, nodes, infraWorkerNodes),
+		}
+
+	default:
+		return NodePlacementProposal{
+			NodePlacement: &sdkapi.NodePlacement{
+				NodeSelector: map[string]string{LabelNodeRoleWorker: ""},
+			},
+			Reason: fmt.Sprintf("%d of the %d nodes have the worker role; the workloads components are placed on them", workerNodes, nodes),
+		}
+	}
+}
+
+func isInfraNode(node corev1.Node) bool {
+	_, exists := node.Labels[LabelNodeRoleInfra]
+	return exists
+}
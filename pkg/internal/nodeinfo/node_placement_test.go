@@ -0,0 +1,135 @@
+package nodeinfo_test
+
+import (
+	"context"
+	"fmt"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/internal/nodeinfo"
+)
+
+var _ = Describe("ProposeNodePlacement", func() {
+	var scheme *runtime.Scheme
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+	})
+
+	newNode := func(name string, taints []corev1.Taint, roles ...string) client.Object {
+		labels := map[string]string{}
+		for _, role := range roles {
+			labels[role] = ""
+		}
+
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels},
+			Spec:       corev1.NodeSpec{Taints: taints},
+		}
+	}
+
+	newNodes := func(prefix string, count int, taints []corev1.Taint, roles ...string) []client.Object {
+		nodes := make([]client.Object, 0, count)
+		for i := range count {
+			nodes = append(nodes, newNode(fmt.Sprintf("%s%d", prefix, i), taints, roles...))
+		}
+		return nodes
+	}
+
+	propose := func(ctx context.Context, nodes ...[]client.Object) (nodeinfo.NodePlacementProposal, nodeinfo.NodePlacementProposal) {
+		var objs []client.Object
+		for _, n := range nodes {
+			objs = append(objs, n...)
+		}
+
+		cli := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+		infra, workloads, err := nodeinfo.ProposeNodePlacement(ctx, cli)
+		Expect(err).ToNot(HaveOccurred())
+		return infra, workloads
+	}
+
+	workerSelector := &sdkapi.NodePlacement{NodeSelector: map[string]string{nodeinfo.LabelNodeRoleWorker: ""}}
+
+	It("should not propose anything if there are no nodes", func(ctx context.Context) {
+		infra, workloads := propose(ctx)
+		Expect(infra.NodePlacement).To(BeNil())
+		Expect(infra.Reason).To(Equal("there are no nodes with the infra role; the infra components can run on any node"))
+		Expect(workloads.NodePlacement).To(BeNil())
+		Expect(workloads.Reason).To(Equal("there are no nodes with the worker role; the workloads components can run on any node"))
+	})
+
+	It("should not restrict the workloads if all the nodes are workers", func(ctx context.Context) {
+		infra, workloads := propose(ctx,
+			newNodes("cp", 3, nil, nodeinfo.LabelNodeRoleControlPlane, nodeinfo.LabelNodeRoleWorker),
+		)
+		Expect(infra.NodePlacement).To(BeNil())
+		Expect(workloads.NodePlacement).To(BeNil())
+		Expect(workloads.Reason).To(Equal("all the nodes have the worker role; the workloads components can run on any node"))
+	})
+
+	It("should place the workloads on the worker nodes", func(ctx context.Context) {
+		infra, workloads := propose(ctx,
+			newNodes("cp", 3, nil, nodeinfo.LabelNodeRoleControlPlane),
+			newNodes("worker", 2, nil, nodeinfo.LabelNodeRoleWorker),
+		)
+		Expect(infra.NodePlacement).To(BeNil())
+		Expect(workloads.NodePlacement).To(Equal(workerSelector))
+		Expect(workloads.Reason).To(Equal("2 of the 5 nodes have the worker role; the workloads components are placed on them"))
+	})
+
+	It("should place the infra components on the infra nodes, and tolerate their taints", func(ctx context.Context) {
+		taints := []corev1.Taint{
+			{Key: nodeinfo.LabelNodeRoleInfra, Effect: corev1.TaintEffectNoExecute},
+			{Key: nodeinfo.LabelNodeRoleInfra, Effect: corev1.TaintEffectNoSchedule},
+			{Key: "other", Effect: corev1.TaintEffectNoSchedule},
+		}
+
+		infra, workloads := propose(ctx,
+			newNodes("cp", 3, nil, nodeinfo.LabelNodeRoleControlPlane),
+			newNodes("infra", 2, taints, nodeinfo.LabelNodeRoleInfra),
+			newNodes("worker", 3, nil, nodeinfo.LabelNodeRoleWorker),
+		)
+
+		Expect(infra.NodePlacement).To(Equal(&sdkapi.NodePlacement{
+			NodeSelector: map[string]string{nodeinfo.LabelNodeRoleInfra: ""},
+			Tolerations: []corev1.Toleration{
+				{Key: nodeinfo.LabelNodeRoleInfra, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoSchedule},
+				{Key: nodeinfo.LabelNodeRoleInfra, Operator: corev1.TolerationOpExists, Effect: corev1.TaintEffectNoExecute},
+			},
+		}))
+		Expect(infra.Reason).To(Equal("2 nodes have the infra role; the infra components are placed on them, with tolerations for the node-role.kubernetes.io/infra taints"))
+
+		Expect(workloads.NodePlacement).To(Equal(workerSelector))
+	})
+
+	It("should exclude the infra nodes from the workloads placement, if they are also workers", func(ctx context.Context) {
+		infra, workloads := propose(ctx,
+			newNodes("cp", 3, nil, nodeinfo.LabelNodeRoleControlPlane),
+			newNodes("infra", 2, nil, nodeinfo.LabelNodeRoleInfra, nodeinfo.LabelNodeRoleWorker),
+			newNodes("worker", 3, nil, nodeinfo.LabelNodeRoleWorker),
+		)
+
+		Expect(infra.NodePlacement).To(Equal(&sdkapi.NodePlacement{
+			NodeSelector: map[string]string{nodeinfo.LabelNodeRoleInfra: ""},
+		}))
+
+		Expect(workloads.NodePlacement).ToNot(BeNil())
+		Expect(workloads.NodePlacement.NodeSelector).To(BeEmpty())
+		Expect(workloads.NodePlacement.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms).To(Equal([]corev1.NodeSelectorTerm{{
+			MatchExpressions: []corev1.NodeSelectorRequirement{
+				{Key: nodeinfo.LabelNodeRoleWorker, Operator: corev1.NodeSelectorOpExists},
+				{Key: nodeinfo.LabelNodeRoleInfra, Operator: corev1.NodeSelectorOpDoesNotExist},
+			},
+		}}))
+		Expect(workloads.Reason).To(Equal("5 of the 8 nodes have the worker role, and 2 of them also have the infra role; the workloads components are placed on the other worker nodes"))
+	})
+})
@@ -0,0 +1,196 @@
+package alertoverrides
+
+import (
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"github.com/prometheus/common/model"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	severityLabelKey     = "severity"
+	healthImpactLabelKey = "operator_health_impact"
+	partOfLabelKey       = "kubernetes_operator_part_of"
+	componentLabelKey    = "kubernetes_operator_component"
+
+	runbookAnnotationKey = "runbook_url"
+)
+
+// ReservedLabels are the alert labels that can't be set by an AlertOverride
+var ReservedLabels = []string{severityLabelKey, healthImpactLabelKey, partOfLabelKey, componentLabelKey}
+
+// Threshold is the tunable threshold of an alert
+type Threshold struct {
+	// Default is the threshold of the alert, if it is not overridden
+	Default float64
+	// Min and Max are the inclusive range of the valid thresholds
+	Min, Max float64
+	// Build returns the alert with the given threshold. Only the expression and the annotations are taken from the
+	// returned alert.
+	Build func(threshold float64) promv1.Rule
+}
+
+// TunableAlerts maps the names of the alerts that can be overridden, to their tunable threshold. The threshold is nil
+// if the alert has no tunable threshold.
+type TunableAlerts map[string]*Threshold
+
+// NewTunableAlerts returns the TunableAlerts of the alerts, with the given thresholds
+func NewTunableAlerts(alerts []promv1.Rule, thresholds map[string]*Threshold) TunableAlerts {
+	tunable := TunableAlerts{}
+	for _, alert := range alerts {
+		tunable[alert.Alert] = thresholds[alert.Alert]
+	}
+	return tunable
+}
+
+// Merge returns the union of the TunableAlerts
+func Merge(tunableAlerts ...TunableAlerts) TunableAlerts {
+	merged := TunableAlerts{}
+	for _, tunable := range tunableAlerts {
+		maps.Copy(merged, tunable)
+	}
+	return merged
+}
+
+// ParseThreshold parses the threshold of an AlertOverride, and checks that it is in the valid range
+func (t *Threshold) ParseThreshold(value string) (float64, error) {
+	threshold, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid threshold %q: %w", value, err)
+	}
+
+	if threshold < t.Min || threshold > t.Max {
+		return 0, fmt.Errorf("the threshold must be between %s and %s", FormatFloat(t.Min), FormatFloat(t.Max))
+	}
+
+	return threshold, nil
+}
+
+// Apply returns a copy of the PrometheusRule, with the runbook base URL and the AlertOverrides of the monitoring
+// configuration. Overrides of alerts that are not in the PrometheusRule are ignored, as they may match the alerts of
+// another PrometheusRule.
+func Apply(rule *promv1.PrometheusRule, monitoring *v1beta1.MonitoringConfig, tunable TunableAlerts) (*promv1.PrometheusRule, error) {
+	rule = rule.DeepCopy()
+	if monitoring == nil || (len(monitoring.Alerts) == 0 && monitoring.RunbookBaseURL == "") {
+		return rule, nil
+	}
+
+	overridesByName := make(map[string]v1beta1.AlertOverride, len(monitoring.Alerts))
+	for _, override := range monitoring.Alerts {
+		overridesByName[override.Name] = override
+	}
+
+	for i := range rule.Spec.Groups {
+		group := &rule.Spec.Groups[i]
+		alerts := make([]promv1.Rule, 0, len(group.Rules))
+
+		for _, alert := range group.Rules {
+			if alert.Alert == "" {
+				alerts = append(alerts, alert)
+				continue
+			}
+
+			if monitoring.RunbookBaseURL != "" {
+				setRunbookURL(&alert, RunbookURL(monitoring.RunbookBaseURL, alert.Alert))
+			}
+
+			override, found := overridesByName[alert.Alert]
+			if !found {
+				alerts = append(alerts, alert)
+				continue
+			}
+
+			if override.Disabled {
+				continue
+			}
+
+			if err := applyOverride(&alert, override, tunable[alert.Alert]); err != nil {
+				return nil, fmt.Errorf("failed to override the %s alert: %w", alert.Alert, err)
+			}
+
+			alerts = append(alerts, alert)
+		}
+
+		group.Rules = alerts
+	}
+
+	return rule, nil
+}
+
+// RunbookURL returns the URL of the runbook of the alert, under the runbook base URL
+func RunbookURL(baseURL, alertName string) string {
+	return strings.TrimSuffix(baseURL, "/") + "/" + alertName
+}
+
+func setRunbookURL(alert *promv1.Rule, runbookURL string) {
+	if alert.Annotations == nil {
+		alert.Annotations = map[string]string{}
+	}
+	alert.Annotations[runbookAnnotationKey] = runbookURL
+}
+
+func applyOverride(alert *promv1.Rule, override v1beta1.AlertOverride, threshold *Threshold) error {
+	if override.Threshold != nil {
+		if threshold == nil {
+			return fmt.Errorf("the alert has no tunable threshold")
+		}
+
+		value, err := threshold.ParseThreshold(*override.Threshold)
+		if err != nil {
+			return err
+		}
+
+		built := threshold.Build(value)
+		alert.Expr = built.Expr
+		if alert.Annotations == nil {
+			alert.Annotations = map[string]string{}
+		}
+		maps.Copy(alert.Annotations, built.Annotations)
+	}
+
+	if override.For != nil {
+		if override.For.Duration == 0 {
+			alert.For = nil
+		} else {
+			alert.For = ptr.To(promv1.Duration(model.Duration(override.For.Duration).String()))
+		}
+	}
+
+	if alert.Labels == nil {
+		alert.Labels = map[string]string{}
+	}
+
+	if override.Severity != "" {
+		alert.Labels[severityLabelKey] = override.Severity
+	}
+
+	for key, value := range override.Labels {
+		if !slices.Contains(ReservedLabels, key) {
+			alert.Labels[key] = value
+		}
+	}
+
+	if override.RunbookURL != "" {
+		setRunbookURL(alert, override.RunbookURL)
+	}
+
+	return nil
+}
+
+// FormatFloat formats a threshold, without an exponent and without trailing zeros
+func FormatFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+// FormatPercentage formats a ratio threshold as a percentage; e.g. 0.9 is formatted as "90%"
+func FormatPercentage(ratio float64) string {
+	return FormatFloat(math.Round(ratio*10000)/100) + "%"
+}
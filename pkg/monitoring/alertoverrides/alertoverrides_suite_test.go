@@ -0,0 +1,13 @@
+package alertoverrides_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+func TestAlertOverrides(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Alert Overrides Suite")
+}
@@ -0,0 +1,164 @@
+package alertoverrides_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
+)
+
+var _ = Describe("Alert overrides", func() {
+	buildThresholdAlert := func(threshold float64) promv1.Rule {
+		return promv1.Rule{
+			Alert: "ThresholdAlert",
+			Expr:  intstr.FromString("some_metric > " + alertoverrides.FormatFloat(threshold)),
+			Annotations: map[string]string{
+				"description": "some_metric is above " + alertoverrides.FormatPercentage(threshold),
+			},
+		}
+	}
+
+	tunable := alertoverrides.TunableAlerts{
+		"ThresholdAlert": &alertoverrides.Threshold{Default: 0.5, Min: 0.1, Max: 1, Build: buildThresholdAlert},
+		"OtherAlert":     nil,
+	}
+
+	newRule := func() *promv1.PrometheusRule {
+		thresholdAlert := buildThresholdAlert(0.5)
+		thresholdAlert.For = ptr.To[promv1.Duration]("5m")
+		thresholdAlert.Labels = map[string]string{"severity": "warning", "operator_health_impact": "none"}
+		thresholdAlert.Annotations["runbook_url"] = "https://example.com/ThresholdAlert"
+
+		return &promv1.PrometheusRule{
+			Spec: promv1.PrometheusRuleSpec{
+				Groups: []promv1.RuleGroup{
+					{
+						Name:  "recordingRules.rules",
+						Rules: []promv1.Rule{{Record: "some_record", Expr: intstr.FromString("sum(some_metric)")}},
+					},
+					{
+						Name: "alerts.rules",
+						Rules: []promv1.Rule{
+							thresholdAlert,
+							{
+								Alert:  "OtherAlert",
+								Expr:   intstr.FromString("other_metric == 0"),
+								For:    ptr.To[promv1.Duration]("1h"),
+								Labels: map[string]string{"severity": "info", "operator_health_impact": "none"},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("should not modify the rule if there are no overrides", func() {
+		rule := newRule()
+		result, err := alertoverrides.Apply(rule, nil, tunable)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(rule))
+		Expect(result).ToNot(BeIdenticalTo(rule))
+	})
+
+	It("should remove the disabled alerts", func() {
+		result, err := alertoverrides.Apply(newRule(), &v1beta1.MonitoringConfig{Alerts: []v1beta1.AlertOverride{{Name: "OtherAlert", Disabled: true}}}, tunable)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Spec.Groups[0].Rules).To(HaveLen(1))
+		Expect(result.Spec.Groups[1].Rules).To(HaveLen(1))
+		Expect(result.Spec.Groups[1].Rules[0].Alert).To(Equal("ThresholdAlert"))
+	})
+
+	It("should override the threshold, the for duration, the severity and the labels", func() {
+		rule := newRule()
+		overrides := []v1beta1.AlertOverride{
+			{
+				Name:      "ThresholdAlert",
+				Threshold: ptr.To("0.75"),
+				For:       &metav1.Duration{Duration: 90 * time.Minute},
+				Severity:  "critical",
+				Labels:    map[string]string{"team": "storage"},
+			},
+			{
+				Name: "OtherAlert",
+				For:  &metav1.Duration{},
+			},
+		}
+
+		result, err := alertoverrides.Apply(rule, &v1beta1.MonitoringConfig{Alerts: overrides}, tunable)
+		Expect(err).ToNot(HaveOccurred())
+
+		alert := result.Spec.Groups[1].Rules[0]
+		Expect(alert.Expr).To(Equal(intstr.FromString("some_metric > 0.75")))
+		Expect(alert.For).To(HaveValue(Equal(promv1.Duration("1h30m"))))
+		Expect(alert.Labels).To(Equal(map[string]string{"severity": "critical", "operator_health_impact": "none", "team": "storage"}))
+		Expect(alert.Annotations).To(Equal(map[string]string{
+			"description": "some_metric is above 75%",
+			"runbook_url": "https://example.com/ThresholdAlert",
+		}))
+
+		Expect(result.Spec.Groups[1].Rules[1].For).To(BeNil())
+
+		By("checking the original rule was not modified")
+		Expect(rule).To(Equal(newRule()))
+	})
+
+	It("should not override the reserved labels", func() {
+		overrides := []v1beta1.AlertOverride{{Name: "OtherAlert", Labels: map[string]string{"operator_health_impact": "critical"}}}
+
+		result, err := alertoverrides.Apply(newRule(), &v1beta1.MonitoringConfig{Alerts: overrides}, tunable)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result.Spec.Groups[1].Rules[1].Labels).To(HaveKeyWithValue("operator_health_impact", "none"))
+	})
+
+	It("should ignore overrides of alerts that are not in the rule", func() {
+		result, err := alertoverrides.Apply(newRule(), &v1beta1.MonitoringConfig{Alerts: []v1beta1.AlertOverride{{Name: "UnknownAlert", Disabled: true}}}, tunable)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(result).To(Equal(newRule()))
+	})
+
+	It("should set the runbook URLs from the runbook base URL", func() {
+		monitoring := &v1beta1.MonitoringConfig{RunbookBaseURL: "https://wiki.example.com/runbooks/"}
+
+		result, err := alertoverrides.Apply(newRule(), monitoring, tunable)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(result.Spec.Groups[0].Rules[0].Annotations).To(BeEmpty())
+		Expect(result.Spec.Groups[1].Rules[0].Annotations).To(HaveKeyWithValue("runbook_url", "https://wiki.example.com/runbooks/ThresholdAlert"))
+		Expect(result.Spec.Groups[1].Rules[1].Annotations).To(HaveKeyWithValue("runbook_url", "https://wiki.example.com/runbooks/OtherAlert"))
+	})
+
+	It("should prefer the runbook URL of the alert override over the runbook base URL", func() {
+		monitoring := &v1beta1.MonitoringConfig{
+			RunbookBaseURL: "https://wiki.example.com/runbooks",
+			Alerts:         []v1beta1.AlertOverride{{Name: "OtherAlert", RunbookURL: "https://wiki.example.com/other-alert"}},
+		}
+
+		result, err := alertoverrides.Apply(newRule(), monitoring, tunable)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(result.Spec.Groups[1].Rules[0].Annotations).To(HaveKeyWithValue("runbook_url", "https://wiki.example.com/runbooks/ThresholdAlert"))
+		Expect(result.Spec.Groups[1].Rules[1].Annotations).To(HaveKeyWithValue("runbook_url", "https://wiki.example.com/other-alert"))
+	})
+
+	DescribeTable("should reject invalid thresholds", func(override v1beta1.AlertOverride, expectedErr string) {
+		_, err := alertoverrides.Apply(newRule(), &v1beta1.MonitoringConfig{Alerts: []v1beta1.AlertOverride{override}}, tunable)
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("no tunable threshold", v1beta1.AlertOverride{Name: "OtherAlert", Threshold: ptr.To("1")}, "the alert has no tunable threshold"),
+		Entry("not a number", v1beta1.AlertOverride{Name: "ThresholdAlert", Threshold: ptr.To("high")}, `invalid threshold "high"`),
+		Entry("out of range", v1beta1.AlertOverride{Name: "ThresholdAlert", Threshold: ptr.To("1.5")}, "the threshold must be between 0.1 and 1"),
+	)
+
+	It("should format the percentages", func() {
+		Expect(alertoverrides.FormatPercentage(0.9)).To(Equal("90%"))
+		Expect(alertoverrides.FormatPercentage(0.125)).To(Equal("12.5%"))
+	})
+})
@@ -0,0 +1,259 @@
+package dashboard
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ConfigMapName is the name of the ConfigMap of the HCO dashboard
+	ConfigMapName = "grafana-dashboard-kubevirt-hyperconverged-cluster-operator"
+
+	configMapNamespace = "openshift-config-managed"
+	dashboardLabelKey  = "console.openshift.io/dashboard"
+	dashboardFileName  = "kubevirt-hyperconverged-cluster-operator.json"
+
+	generatedHeader = "# Code generated by tools/dashboard-generator. DO NOT EDIT.\n"
+
+	fullWidth = 24
+	rowHeight = 1
+
+	colorGreen  = "#299c46"
+	colorOrange = "rgba(237, 129, 40, 0.89)"
+	colorRed    = "#d44a3a"
+)
+
+var (
+	// the colors of the 0, 1 and 2 values of the singlestat panels
+	healthColors = []string{colorGreen, colorOrange, colorRed}
+	existsColors = []string{colorRed, colorGreen, colorGreen}
+)
+
+// Build builds the HCO dashboard. The panels query the metrics and the recording rules by their names, and take their
+// descriptions from the help text of the metrics; building the dashboard fails if one of them is not in the given
+// definitions, so the dashboard can't drift from the metric names.
+func Build(metricsList []operatormetrics.Metric, recordingRules []operatorrules.RecordingRule) (*Dashboard, error) {
+	b := newBuilder(metricsList, recordingRules)
+
+	b.addRow("Health")
+	b.addSingleStat(8, "HCO health status", "kubevirt_hyperconverged_operator_health_status", "%s",
+		healthValueMaps("Critical"), healthColors)
+	b.addSingleStat(8, "System health status", "kubevirt_hco_system_health_status", "%s",
+		healthValueMaps("Error"), healthColors)
+	b.addSingleStat(8, "HyperConverged CR exists", "kubevirt_hco_hyperconverged_cr_exists", "%s",
+		[]ValueMap{{Op: "=", Value: "0", Text: "No"}, {Op: "=", Value: "1", Text: "Yes"}}, existsColors)
+
+	b.addRow("Operands")
+	b.addTable(12, "Operand health status", "kubevirt_hco_operand_health_status", "%s",
+		Style{Alias: "Health status", Pattern: "Value", Type: "number"})
+	b.addTable(12, "Operand conditions", "kubevirt_hco_operand_condition", "%s",
+		Style{Alias: "Status", Pattern: "Value", Type: "number"})
+	b.addGraph(12, "Operand ensure results rate", "kubevirt_hco_operand_ensure_results_total",
+		"sum by (component_name, result) (rate(%s[5m]))", "{{component_name}} {{result}}", "ops")
+	b.addGraph(12, "Frozen operands", "kubevirt_hco_frozen_operands",
+		"%s", "{{component_name}}", "short")
+
+	b.addRow("Modifications")
+	b.addGraph(12, "Out-of-band modification rate", "kubevirt_hco_out_of_band_modifications_total",
+		"sum by (component_name) (rate(%s[5m]))", "{{component_name}}", "ops")
+	b.addGraph(12, "Unsafe annotations", "kubevirt_hco_unsafe_modifications",
+		"%s", "{{annotation_name}}", "short")
+
+	b.addRow("Golden Images")
+	b.addTable(12, "DataImportCronTemplates with no supported architectures",
+		"kubevirt_hco_dataimportcrontemplate_with_supported_architectures", "%s == 0")
+	b.addTable(12, "DataImportCronTemplates with no architecture annotation",
+		"kubevirt_hco_dataimportcrontemplate_with_architecture_annotation", "%s == 0")
+
+	b.addRow("Upgrade")
+	b.addSingleStat(6, "Upgrade in progress", "kubevirt_hco_upgrade_in_progress", "%s",
+		[]ValueMap{{Op: "=", Value: "0", Text: "No"}, {Op: "=", Value: "1", Text: "Yes"}}, healthColors)
+	b.addTable(6, "Upgrade blocking operand", "kubevirt_hco_upgrade_blocking_operand", "%s == 1",
+		Style{Pattern: "Value", Type: "hidden"})
+	b.addTable(12, "Operand versions", "kubevirt_hco_operand_version", "%s",
+		Style{Alias: "Upgraded", Pattern: "Value", Type: "number"})
+	b.addGraph(12, "Upgrade patches", "kubevirt_hco_upgrade_patches_total",
+		"sum by (outcome) (increase(%s[1h]))", "{{outcome}}", "short")
+	b.addGraph(12, "Reconcile phase duration (p95)", "kubevirt_hco_reconcile_phase_duration_seconds",
+		"histogram_quantile(0.95, sum by (phase, le) (rate(%s_bucket[5m])))", "{{phase}}", "s")
+
+	if b.err != nil {
+		return nil, b.err
+	}
+
+	return &Dashboard{
+		Editable:      true,
+		Panels:        b.panels,
+		SchemaVersion: 22,
+		Tags:          []string{"kubevirt-mixin"},
+		Templating: Templating{List: []Variable{{
+			Name:    "datasource",
+			Label:   "Datasource",
+			Type:    "datasource",
+			Query:   "prometheus",
+			Current: map[string]string{"text": "default", "value": "default"},
+		}}},
+		Time:     TimeRange{From: "now-1h", To: "now"},
+		Timezone: "UTC",
+		Title:    "KubeVirt / HyperConverged Cluster Operator",
+		UID:      "kubevirt-hco",
+	}, nil
+}
+
+// BuildConfigMap builds the ConfigMap of the HCO dashboard, in the format of the other ConfigMaps in assets/dashboards
+func BuildConfigMap(metricsList []operatormetrics.Metric, recordingRules []operatorrules.RecordingRule) ([]byte, error) {
+	dashboard, err := Build(metricsList, recordingRules)
+	if err != nil {
+		return nil, err
+	}
+
+	dashboardJSON, err := json.MarshalIndent(dashboard, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	cm := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      ConfigMapName,
+			Namespace: configMapNamespace,
+			Labels:    map[string]string{dashboardLabelKey: "true"},
+		},
+		Data: map[string]string{dashboardFileName: string(dashboardJSON) + "\n"},
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(cm)
+	if err != nil {
+		return nil, err
+	}
+	delete(u["metadata"].(map[string]any), "creationTimestamp")
+
+	cmYAML, err := yaml.Marshal(u)
+	if err != nil {
+		return nil, err
+	}
+
+	return append([]byte(generatedHeader), cmYAML...), nil
+}
+
+func healthValueMaps(errorText string) []ValueMap {
+	return []ValueMap{
+		{Op: "=", Value: "0", Text: "Healthy"},
+		{Op: "=", Value: "1", Text: "Warning"},
+		{Op: "=", Value: "2", Text: errorText},
+	}
+}
+
+type builder struct {
+	help   map[string]string
+	panels []Panel
+	nextID int
+	err    error
+
+	// the position of the next panel, and the height of the current line of panels
+	x, y, lineHeight int
+}
+
+func newBuilder(metricsList []operatormetrics.Metric, recordingRules []operatorrules.RecordingRule) *builder {
+	help := make(map[string]string, len(metricsList)+len(recordingRules))
+	for _, m := range metricsList {
+		help[m.GetOpts().Name] = m.GetOpts().Help
+	}
+	for _, r := range recordingRules {
+		help[r.GetOpts().Name] = r.GetOpts().Help
+	}
+
+	return &builder{help: help, nextID: 1}
+}
+
+// query returns the query of a panel and the description of the metric it is based on
+func (b *builder) query(metricName, queryFormat string) (string, string) {
+	help, found := b.help[metricName]
+	if !found {
+		b.err = errors.Join(b.err, fmt.Errorf("unknown metric %q", metricName))
+	}
+
+	return fmt.Sprintf(queryFormat, metricName), help
+}
+
+func (b *builder) addRow(title string) {
+	b.newLine()
+	b.add(Panel{Type: panelTypeRow, Title: title}, fullWidth, rowHeight)
+}
+
+// add places the panel next to the previous panel, or in a new line if there is no room for it
+func (b *builder) add(panel Panel, width, height int) {
+	if b.x+width > fullWidth {
+		b.newLine()
+	}
+
+	panel.ID = b.nextID
+	panel.GridPos = GridPos{H: height, W: width, X: b.x, Y: b.y}
+	b.panels = append(b.panels, panel)
+
+	b.nextID++
+	b.x += width
+	b.lineHeight = max(b.lineHeight, height)
+}
+
+func (b *builder) newLine() {
+	b.y += b.lineHeight
+	b.x, b.lineHeight = 0, 0
+}
+
+func (b *builder) addSingleStat(width int, title, metricName, queryFormat string, valueMaps []ValueMap, colors []string) {
+	expr, help := b.query(metricName, queryFormat)
+	b.add(Panel{
+		Type:            panelTypeSingleStat,
+		Title:           title,
+		Description:     help,
+		Datasource:      datasource,
+		Targets:         []Target{{Expr: expr, Instant: true, RefID: "A"}},
+		Format:          "none",
+		ValueName:       "current",
+		ValueMaps:       valueMaps,
+		Thresholds:      "1,2",
+		Colors:          colors,
+		ColorBackground: true,
+	}, width, 4)
+}
+
+func (b *builder) addGraph(width int, title, metricName, queryFormat, legendFormat, unit string) {
+	expr, help := b.query(metricName, queryFormat)
+	zero := float64(0)
+	b.add(Panel{
+		Type:        panelTypeGraph,
+		Title:       title,
+		Description: help,
+		Datasource:  datasource,
+		Targets:     []Target{{Expr: expr, LegendFormat: legendFormat, RefID: "A"}},
+		Legend:      &Legend{Show: true},
+		YAxes:       []YAxis{{Format: unit, Min: &zero, Show: true}, {Format: "short", Show: false}},
+	}, width, 8)
+}
+
+func (b *builder) addTable(width int, title, metricName, queryFormat string, styles ...Style) {
+	expr, help := b.query(metricName, queryFormat)
+	b.add(Panel{
+		Type:        panelTypeTable,
+		Title:       title,
+		Description: help,
+		Datasource:  datasource,
+		Targets:     []Target{{Expr: expr, Format: "table", Instant: true, RefID: "A"}},
+		Styles: append([]Style{
+			{Pattern: "Time", Type: "hidden"},
+			{Pattern: "__name__", Type: "hidden"},
+		}, styles...),
+	}, width, 8)
+}
@@ -0,0 +1,21 @@
+package dashboard_test
+
+import (
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+)
+
+func TestDashboard(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Dashboard Suite")
+}
+
+var _ = BeforeSuite(func() {
+	Expect(metrics.SetupMetrics()).To(Succeed())
+	Expect(rules.SetupRules()).To(Succeed())
+})
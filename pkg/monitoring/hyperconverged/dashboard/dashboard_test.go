@@ -0,0 +1,60 @@
+package dashboard_test
+
+import (
+	"os"
+	"slices"
+
+	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/dashboard"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+)
+
+const dashboardFile = "../../../../assets/dashboards/grafana-dashboard-kubevirt-hyperconverged-cluster-operator.yaml"
+
+var _ = Describe("HCO dashboard", func() {
+	It("should build a panel for each queried metric, with the metric help as the description", func() {
+		d, err := dashboard.Build(metrics.ListMetrics(), rules.ListRecordingRules())
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(d.Panels).ToNot(BeEmpty())
+
+		ids := map[int]struct{}{}
+		for _, panel := range d.Panels {
+			Expect(ids).ToNot(HaveKey(panel.ID), "duplicated panel id")
+			ids[panel.ID] = struct{}{}
+
+			Expect(panel.GridPos.X + panel.GridPos.W).To(BeNumerically("<=", 24))
+
+			if panel.Type == "row" {
+				Expect(panel.Targets).To(BeEmpty())
+				continue
+			}
+
+			Expect(panel.Targets).To(HaveLen(1))
+			Expect(panel.Description).ToNot(BeEmpty())
+		}
+	})
+
+	It("should fail if a queried metric is not defined", func() {
+		metricsList := slices.DeleteFunc(slices.Clone(metrics.ListMetrics()), func(m operatormetrics.Metric) bool {
+			return m.GetOpts().Name == "kubevirt_hco_unsafe_modifications"
+		})
+
+		_, err := dashboard.Build(metricsList, rules.ListRecordingRules())
+		Expect(err).To(MatchError(ContainSubstring(`unknown metric "kubevirt_hco_unsafe_modifications"`)))
+	})
+
+	It("should match the generated dashboard in the assets directory", func() {
+		cm, err := dashboard.BuildConfigMap(metrics.ListMetrics(), rules.ListRecordingRules())
+		Expect(err).ToNot(HaveOccurred())
+
+		generated, err := os.ReadFile(dashboardFile)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(string(cm)).To(Equal(string(generated)), "the dashboard is outdated; run make generate-doc")
+	})
+})
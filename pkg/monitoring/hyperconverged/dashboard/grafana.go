@@ -0,0 +1,119 @@
+package dashboard
+
+// The minimal subset of the Grafana dashboard JSON model, that is needed to build the HCO dashboard. Only the panel
+// types that are supported by the OpenShift console dashboards are used: row, singlestat, graph and table.
+
+const (
+	panelTypeRow        = "row"
+	panelTypeSingleStat = "singlestat"
+	panelTypeGraph      = "graph"
+	panelTypeTable      = "table"
+
+	datasource = "$datasource"
+)
+
+// Dashboard is a Grafana dashboard
+type Dashboard struct {
+	Editable      bool       `json:"editable"`
+	Panels        []Panel    `json:"panels"`
+	Refresh       string     `json:"refresh"`
+	SchemaVersion int        `json:"schemaVersion"`
+	Tags          []string   `json:"tags"`
+	Templating    Templating `json:"templating"`
+	Time          TimeRange  `json:"time"`
+	Timezone      string     `json:"timezone"`
+	Title         string     `json:"title"`
+	UID           string     `json:"uid"`
+	Version       int        `json:"version"`
+}
+
+// Panel is a single panel of a Grafana dashboard
+type Panel struct {
+	ID          int     `json:"id"`
+	Type        string  `json:"type"`
+	Title       string  `json:"title"`
+	Description string  `json:"description,omitempty"`
+	Datasource  string  `json:"datasource,omitempty"`
+	GridPos     GridPos `json:"gridPos"`
+
+	Targets []Target `json:"targets,omitempty"`
+
+	// graph panels
+	Legend *Legend `json:"legend,omitempty"`
+	YAxes  []YAxis `json:"yaxes,omitempty"`
+
+	// singlestat panels
+	Format          string     `json:"format,omitempty"`
+	ValueName       string     `json:"valueName,omitempty"`
+	ValueMaps       []ValueMap `json:"valueMaps,omitempty"`
+	Thresholds      string     `json:"thresholds,omitempty"`
+	Colors          []string   `json:"colors,omitempty"`
+	ColorBackground bool       `json:"colorBackground,omitempty"`
+
+	// table panels
+	Styles []Style `json:"styles,omitempty"`
+}
+
+// GridPos is the position and the size of a panel
+type GridPos struct {
+	H int `json:"h"`
+	W int `json:"w"`
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+// Target is a Prometheus query of a panel
+type Target struct {
+	Expr         string `json:"expr"`
+	Format       string `json:"format,omitempty"`
+	Instant      bool   `json:"instant,omitempty"`
+	LegendFormat string `json:"legendFormat,omitempty"`
+	RefID        string `json:"refId"`
+}
+
+// Legend is the legend of a graph panel
+type Legend struct {
+	Show bool `json:"show"`
+}
+
+// YAxis is a Y axis of a graph panel
+type YAxis struct {
+	Format string   `json:"format"`
+	Min    *float64 `json:"min,omitempty"`
+	Show   bool     `json:"show"`
+}
+
+// ValueMap maps a value of a singlestat panel to a text
+type ValueMap struct {
+	Op    string `json:"op"`
+	Text  string `json:"text"`
+	Value string `json:"value"`
+}
+
+// Style is the style of a table panel column
+type Style struct {
+	Alias   string `json:"alias,omitempty"`
+	Pattern string `json:"pattern"`
+	Type    string `json:"type"`
+}
+
+// Templating holds the variables of the dashboard
+type Templating struct {
+	List []Variable `json:"list"`
+}
+
+// Variable is a dashboard variable
+type Variable struct {
+	Name    string `json:"name"`
+	Label   string `json:"label,omitempty"`
+	Type    string `json:"type"`
+	Query   string `json:"query"`
+	Hide    int    `json:"hide"`
+	Current any    `json:"current"`
+}
+
+// TimeRange is the default time range of the dashboard
+type TimeRange struct {
+	From string `json:"from"`
+	To   string `json:"to"`
+}
@@ -1,15 +1,26 @@
 package metrics
 
 import (
+	"slices"
 	"strings"
+	"time"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatormetrics"
+	"github.com/prometheus/client_golang/prometheus"
 	ioprometheusclient "github.com/prometheus/client_model/go"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const (
 	counterLabelCompName = "component_name"
 	counterLabelAnnName  = "annotation_name"
+	labelCondition       = "condition"
+	labelReason          = "reason"
+	labelPhase           = "phase"
+	labelResult          = "result"
+	labelOutcome         = "outcome"
+	labelObservedVersion = "observed_version"
+	labelTargetVersion   = "target_version"
 
 	hyperConvergedExists    = 1.0
 	hyperConvergedNotExists = 0.0
@@ -21,6 +32,32 @@ const (
 	SystemHealthStatusError
 )
 
+const (
+	OperandConditionTrue    float64 = 1
+	OperandConditionFalse   float64 = 0
+	OperandConditionUnknown float64 = -1
+)
+
+// The phases of the HyperConverged reconciliation, that are reported by the
+// kubevirt_hco_reconcile_phase_duration_seconds metric
+const (
+	ReconcilePhaseUpgrade      = "upgrade"
+	ReconcilePhaseOperands     = "operands"
+	ReconcilePhaseStatusUpdate = "status_update"
+)
+
+// The results of ensuring an operand, that are reported by the kubevirt_hco_operand_ensure_results_total metric
+const (
+	EnsureResultCreated     = "created"
+	EnsureResultUpdated     = "updated"
+	EnsureResultOverwritten = "overwritten"
+	EnsureResultDeleted     = "deleted"
+	EnsureResultError       = "error"
+)
+
+// OperandConditionTypes are the operand conditions that are reported by the kubevirt_hco_operand_condition metric
+var OperandConditionTypes = []string{"Available", "Progressing", "Degraded", "Upgradeable"}
+
 const (
 	counterLabelDICTName = "data_import_cron_name"
 	counterLabelDSName   = "managed_data_source_name"
@@ -40,6 +77,17 @@ var (
 		systemHealthStatus,
 		dictWithSupportedArchitectures,
 		dictWithArchitectureAnnotation,
+		frozenOperands,
+		operandReconcileDuration,
+		operandConditions,
+		reconcilePhaseDuration,
+		operandEnsureResults,
+		upgradePatches,
+		operandVersions,
+		upgradeInProgress,
+		upgradeStartTime,
+		upgradeCompletionTime,
+		upgradeBlockingOperand,
 	}
 
 	overwrittenModifications = operatormetrics.NewCounterVec(
@@ -87,6 +135,97 @@ var (
 		},
 		[]string{counterLabelDICTName, counterLabelDSName},
 	)
+
+	frozenOperands = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_frozen_operands",
+			Help: "Indicates whether the operand is frozen (1) by the hco.kubevirt.io/frozen-operands annotation, and is not reconciled by HCO",
+		},
+		[]string{counterLabelCompName},
+	)
+
+	operandReconcileDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_reconcile_duration_seconds",
+			Help: "Duration of the reconciliation of a single operand by HCO, in seconds",
+		},
+		prometheus.HistogramOpts{
+			Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+		},
+		[]string{counterLabelCompName},
+	)
+
+	operandConditions = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_condition",
+			Help: "Indicates the status of the Available, Progressing, Degraded and Upgradeable conditions of each operand of HCO: true (1), false (0) or unknown (-1). The reason label holds the reason of the condition",
+		},
+		[]string{counterLabelCompName, labelCondition, labelReason},
+	)
+
+	reconcilePhaseDuration = operatormetrics.NewHistogramVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_reconcile_phase_duration_seconds",
+			Help: "Duration of a phase of the HyperConverged reconciliation, in seconds. The phase is upgrade (applying the upgrade patches), operands (ensuring the operands) or status_update (updating the HyperConverged CR and its status)",
+		},
+		prometheus.HistogramOpts{
+			Buckets: []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30},
+		},
+		[]string{labelPhase},
+	)
+
+	operandEnsureResults = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_ensure_results_total",
+			Help: "Count of the operand reconciliations by HCO that changed the operand or failed, by result: created, updated, overwritten (an out-of-band modification was reverted), deleted or error",
+		},
+		[]string{counterLabelCompName, labelResult},
+	)
+
+	upgradePatches = operatormetrics.NewCounterVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_patches_total",
+			Help: "Count of the upgrade patches evaluated by HCO during upgrades, by outcome: Applied, Skipped or TestFailed",
+		},
+		[]string{labelOutcome},
+	)
+
+	operandVersions = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_version",
+			Help: "Indicates whether the version an operand of HCO reports matches the version HCO deploys (1) or not (0). The observed_version and the target_version labels hold the versions",
+		},
+		[]string{counterLabelCompName, labelObservedVersion, labelTargetVersion},
+	)
+
+	upgradeInProgress = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_in_progress",
+			Help: "Indicates whether an HCO upgrade is in progress (1) or not (0)",
+		},
+	)
+
+	upgradeStartTime = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_start_timestamp_seconds",
+			Help: "The time the current or the last HCO upgrade started, in seconds since the epoch",
+		},
+	)
+
+	upgradeCompletionTime = operatormetrics.NewGauge(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_completion_timestamp_seconds",
+			Help: "The time the last HCO upgrade was completed, in seconds since the epoch",
+		},
+	)
+
+	upgradeBlockingOperand = operatormetrics.NewGaugeVec(
+		operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_upgrade_blocking_operand",
+			Help: "Indicates the operand (1) that blocks the completion of the current HCO upgrade, as it is not upgraded yet",
+		},
+		[]string{counterLabelCompName},
+	)
 )
 
 // IncOverwrittenModifications increments counter by 1
@@ -208,6 +347,279 @@ func IsDICTWithArchitectureAnnotation(dictName, dsName string) (bool, error) {
 	return value == hasArchitectureAnnotation, nil
 }
 
+// SetOperandFrozen sets the gauge to 1 for a frozen operand
+func SetOperandFrozen(kind, name string) {
+	frozenOperands.WithLabelValues(getLabelsForObj(kind, name)).Set(1)
+}
+
+// ResetFrozenOperands removes all the frozen operands from the gauge
+func ResetFrozenOperands() {
+	frozenOperands.Reset()
+}
+
+// IsOperandFrozen returns true if the operand is marked as frozen. If error is not nil then value is undefined
+func IsOperandFrozen(kind, name string) (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := frozenOperands.WithLabelValues(getLabelsForObj(kind, name)).Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return false, err
+	}
+
+	return value == 1, nil
+}
+
+// ObserveOperandReconcileDuration records the duration of a single operand reconciliation
+func ObserveOperandReconcileDuration(kind, name string, duration time.Duration) {
+	operandReconcileDuration.WithLabelValues(getLabelsForObj(kind, name)).Observe(duration.Seconds())
+}
+
+// GetOperandReconcileCount returns the number of the recorded reconciliations of an operand. If error is not nil
+// then value is undefined
+func GetOperandReconcileCount(kind, name string) (uint64, error) {
+	dto := &ioprometheusclient.Metric{}
+	observer, err := operandReconcileDuration.GetMetricWithLabelValues(getLabelsForObj(kind, name))
+	if err != nil {
+		return 0, err
+	}
+
+	if err = observer.(prometheus.Metric).Write(dto); err != nil {
+		return 0, err
+	}
+
+	return dto.Histogram.GetSampleCount(), nil
+}
+
+// ObserveReconcilePhaseDuration records the duration of a phase of the HyperConverged reconciliation
+func ObserveReconcilePhaseDuration(phase string, duration time.Duration) {
+	reconcilePhaseDuration.WithLabelValues(phase).Observe(duration.Seconds())
+}
+
+// GetReconcilePhaseCount returns the number of the recorded durations of a reconciliation phase. If error is not nil
+// then value is undefined
+func GetReconcilePhaseCount(phase string) (uint64, error) {
+	dto := &ioprometheusclient.Metric{}
+	observer, err := reconcilePhaseDuration.GetMetricWithLabelValues(phase)
+	if err != nil {
+		return 0, err
+	}
+
+	if err = observer.(prometheus.Metric).Write(dto); err != nil {
+		return 0, err
+	}
+
+	return dto.Histogram.GetSampleCount(), nil
+}
+
+// IncOperandEnsureResult increments the counter of an operand ensure result by 1
+func IncOperandEnsureResult(kind, name, result string) {
+	operandEnsureResults.WithLabelValues(getLabelsForObj(kind, name), result).Inc()
+}
+
+// GetOperandEnsureResultCount returns current value of the counter. If error is not nil then value is undefined
+func GetOperandEnsureResultCount(kind, name, result string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := operandEnsureResults.WithLabelValues(getLabelsForObj(kind, name), result).Write(dto)
+	value := dto.Counter.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// IncUpgradePatches increments the counter of an upgrade patch outcome by 1
+func IncUpgradePatches(outcome string) {
+	upgradePatches.WithLabelValues(outcome).Inc()
+}
+
+// GetUpgradePatchesCount returns current value of the counter. If error is not nil then value is undefined
+func GetUpgradePatchesCount(outcome string) (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradePatches.WithLabelValues(outcome).Write(dto)
+	value := dto.Counter.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// SetOperandVersion replaces the reported versions of an operand
+func SetOperandVersion(kind, name, observedVersion, targetVersion string) {
+	component := getLabelsForObj(kind, name)
+	operandVersions.DeletePartialMatch(prometheus.Labels{counterLabelCompName: component})
+
+	upgraded := 0.0
+	if observedVersion == targetVersion {
+		upgraded = 1.0
+	}
+
+	operandVersions.WithLabelValues(component, observedVersion, targetVersion).Set(upgraded)
+}
+
+// DeleteOperandVersion removes the reported versions of an operand; e.g. when the operand is removed
+func DeleteOperandVersion(kind, name string) {
+	operandVersions.DeletePartialMatch(prometheus.Labels{counterLabelCompName: getLabelsForObj(kind, name)})
+}
+
+// GetOperandVersion returns the value of the gauge of an operand, and the observed and the target versions it is
+// reported with. The returned bool is false if the operand is not reported.
+func GetOperandVersion(kind, name string) (float64, string, string, bool, error) {
+	component := getLabelsForObj(kind, name)
+
+	value, labels, found, err := findSeries(operandVersions, func(labels map[string]string) bool {
+		return labels[counterLabelCompName] == component
+	})
+
+	return value, labels[labelObservedVersion], labels[labelTargetVersion], found, err
+}
+
+// SetUpgradeStatus reports the progress of the current or the last HCO upgrade. A zero time is not reported. The
+// blocking operand is in the form of <kind>/<name>, or empty if no operand blocks the upgrade.
+func SetUpgradeStatus(inProgress bool, startTime, completionTime time.Time, blockingOperand string) {
+	if inProgress {
+		upgradeInProgress.Set(1)
+	} else {
+		upgradeInProgress.Set(0)
+	}
+
+	if !startTime.IsZero() {
+		upgradeStartTime.Set(float64(startTime.Unix()))
+	}
+
+	if !completionTime.IsZero() {
+		upgradeCompletionTime.Set(float64(completionTime.Unix()))
+	}
+
+	upgradeBlockingOperand.Reset()
+	if inProgress && blockingOperand != "" {
+		upgradeBlockingOperand.WithLabelValues(strings.ToLower(blockingOperand)).Set(1)
+	}
+}
+
+// IsUpgradeInProgress returns true if an HCO upgrade is reported as in progress
+func IsUpgradeInProgress() (bool, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradeInProgress.Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return false, err
+	}
+	return value == 1, nil
+}
+
+// GetUpgradeStartTime returns the reported start time of the HCO upgrade, in seconds since the epoch
+func GetUpgradeStartTime() (float64, error) {
+	dto := &ioprometheusclient.Metric{}
+	err := upgradeStartTime.Write(dto)
+	value := dto.Gauge.GetValue()
+
+	if err != nil {
+		return 0, err
+	}
+	return value, nil
+}
+
+// GetUpgradeBlockingOperand returns the reported operand that blocks the upgrade. The returned bool is false if no
+// operand is reported.
+func GetUpgradeBlockingOperand() (string, bool, error) {
+	_, labels, found, err := findSeries(upgradeBlockingOperand, func(map[string]string) bool {
+		return true
+	})
+
+	return labels[counterLabelCompName], found, err
+}
+
+// SetOperandConditions replaces the reported conditions of an operand with its current conditions. Only the condition
+// types in OperandConditionTypes are reported.
+func SetOperandConditions(kind, name string, conditions []metav1.Condition) {
+	component := getLabelsForObj(kind, name)
+
+	// the reason is a label, so the series of the previous reasons must be removed
+	operandConditions.DeletePartialMatch(prometheus.Labels{counterLabelCompName: component})
+
+	for _, cond := range conditions {
+		if !slices.Contains(OperandConditionTypes, cond.Type) {
+			continue
+		}
+
+		operandConditions.WithLabelValues(component, cond.Type, cond.Reason).Set(getOperandConditionValue(cond.Status))
+	}
+}
+
+// DeleteOperandConditions removes the reported conditions of an operand; e.g. when the operand is removed
+func DeleteOperandConditions(kind, name string) {
+	operandConditions.DeletePartialMatch(prometheus.Labels{counterLabelCompName: getLabelsForObj(kind, name)})
+}
+
+// ResetOperandConditions removes the reported conditions of all the operands
+func ResetOperandConditions() {
+	operandConditions.Reset()
+}
+
+// GetOperandCondition returns the value and the reason of a reported operand condition. The returned bool is false if
+// the condition is not reported. If error is not nil then the values are undefined
+func GetOperandCondition(kind, name, conditionType string) (float64, string, bool, error) {
+	component := getLabelsForObj(kind, name)
+
+	value, labels, found, err := findSeries(operandConditions, func(labels map[string]string) bool {
+		return labels[counterLabelCompName] == component && labels[labelCondition] == conditionType
+	})
+
+	return value, labels[labelReason], found, err
+}
+
+// findSeries returns the value and the labels of the first series of the collector that matches the labels
+func findSeries(collector prometheus.Collector, match func(labels map[string]string) bool) (float64, map[string]string, bool, error) {
+	ch := make(chan prometheus.Metric, 100)
+	go func() {
+		collector.Collect(ch)
+		close(ch)
+	}()
+
+	var (
+		value  float64
+		labels map[string]string
+		found  bool
+		err    error
+	)
+	for m := range ch {
+		if found || err != nil {
+			continue
+		}
+
+		dto := &ioprometheusclient.Metric{}
+		if err = m.Write(dto); err != nil {
+			continue
+		}
+
+		seriesLabels := make(map[string]string, len(dto.GetLabel()))
+		for _, label := range dto.GetLabel() {
+			seriesLabels[label.GetName()] = label.GetValue()
+		}
+
+		if match(seriesLabels) {
+			value, labels, found = dto.Gauge.GetValue(), seriesLabels, true
+		}
+	}
+
+	return value, labels, found, err
+}
+
+func getOperandConditionValue(status metav1.ConditionStatus) float64 {
+	switch status {
+	case metav1.ConditionTrue:
+		return OperandConditionTrue
+	case metav1.ConditionFalse:
+		return OperandConditionFalse
+	default:
+		return OperandConditionUnknown
+	}
+}
+
 func getLabelsForObj(kind string, name string) string {
 	return strings.ToLower(kind + "/" + name)
 }
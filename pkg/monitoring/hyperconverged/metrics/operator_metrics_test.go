@@ -1,8 +1,11 @@
 package metrics_test
 
 import (
+	"time"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 )
@@ -21,4 +24,159 @@ var _ = Describe("Operator Metrics", func() {
 			Expect(v).To(Equal(metrics.SystemHealthStatusWarning))
 		})
 	})
+
+	Context("kubevirt_hco_operand_condition", func() {
+		BeforeEach(func() {
+			metrics.ResetOperandConditions()
+		})
+
+		expectCondition := func(conditionType string, expectedValue float64, expectedReason string) {
+			GinkgoHelper()
+			value, reason, found, err := metrics.GetOperandCondition("CDI", "cdi-kubevirt-hyperconverged", conditionType)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(expectedValue))
+			Expect(reason).To(Equal(expectedReason))
+		}
+
+		It("should report the operand conditions", func() {
+			metrics.SetOperandConditions("CDI", "cdi-kubevirt-hyperconverged", []metav1.Condition{
+				{Type: "Available", Status: metav1.ConditionTrue, Reason: "AsExpected"},
+				{Type: "Progressing", Status: metav1.ConditionFalse, Reason: "AsExpected"},
+				{Type: "Degraded", Status: metav1.ConditionUnknown, Reason: "Unknown"},
+				{Type: "Upgradeable", Status: metav1.ConditionTrue, Reason: "AsExpected"},
+				{Type: "Other", Status: metav1.ConditionTrue, Reason: "Ignored"},
+			})
+
+			expectCondition("Available", metrics.OperandConditionTrue, "AsExpected")
+			expectCondition("Progressing", metrics.OperandConditionFalse, "AsExpected")
+			expectCondition("Degraded", metrics.OperandConditionUnknown, "Unknown")
+			expectCondition("Upgradeable", metrics.OperandConditionTrue, "AsExpected")
+
+			_, _, found, err := metrics.GetOperandCondition("CDI", "cdi-kubevirt-hyperconverged", "Other")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("should remove the series of the previous reasons", func() {
+			metrics.SetOperandConditions("CDI", "cdi-kubevirt-hyperconverged", []metav1.Condition{
+				{Type: "Available", Status: metav1.ConditionFalse, Reason: "Deploying"},
+				{Type: "Degraded", Status: metav1.ConditionTrue, Reason: "Failing"},
+			})
+			metrics.SetOperandConditions("CDI", "cdi-kubevirt-hyperconverged", []metav1.Condition{
+				{Type: "Available", Status: metav1.ConditionTrue, Reason: "Deployed"},
+			})
+
+			expectCondition("Available", metrics.OperandConditionTrue, "Deployed")
+
+			_, _, found, err := metrics.GetOperandCondition("CDI", "cdi-kubevirt-hyperconverged", "Degraded")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("should delete the conditions of a single operand", func() {
+			conditions := []metav1.Condition{{Type: "Available", Status: metav1.ConditionTrue, Reason: "AsExpected"}}
+			metrics.SetOperandConditions("CDI", "cdi-kubevirt-hyperconverged", conditions)
+			metrics.SetOperandConditions("AAQ", "aaq-kubevirt-hyperconverged", conditions)
+
+			metrics.DeleteOperandConditions("AAQ", "aaq-kubevirt-hyperconverged")
+
+			_, _, found, err := metrics.GetOperandCondition("AAQ", "aaq-kubevirt-hyperconverged", "Available")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+
+			expectCondition("Available", metrics.OperandConditionTrue, "AsExpected")
+		})
+	})
+
+	Context("kubevirt_hco_reconcile_phase_duration_seconds", func() {
+		It("should record the duration of each phase", func() {
+			before, err := metrics.GetReconcilePhaseCount(metrics.ReconcilePhaseOperands)
+			Expect(err).ToNot(HaveOccurred())
+
+			metrics.ObserveReconcilePhaseDuration(metrics.ReconcilePhaseOperands, time.Second)
+
+			Expect(metrics.GetReconcilePhaseCount(metrics.ReconcilePhaseOperands)).To(Equal(before + 1))
+		})
+	})
+
+	Context("kubevirt_hco_operand_ensure_results_total", func() {
+		It("should count the results of each operand separately", func() {
+			before, err := metrics.GetOperandEnsureResultCount("SSP", "ssp-kubevirt-hyperconverged", metrics.EnsureResultUpdated)
+			Expect(err).ToNot(HaveOccurred())
+			otherBefore, err := metrics.GetOperandEnsureResultCount("SSP", "ssp-kubevirt-hyperconverged", metrics.EnsureResultError)
+			Expect(err).ToNot(HaveOccurred())
+
+			metrics.IncOperandEnsureResult("SSP", "ssp-kubevirt-hyperconverged", metrics.EnsureResultUpdated)
+
+			Expect(metrics.GetOperandEnsureResultCount("SSP", "ssp-kubevirt-hyperconverged", metrics.EnsureResultUpdated)).To(Equal(before + 1))
+			Expect(metrics.GetOperandEnsureResultCount("SSP", "ssp-kubevirt-hyperconverged", metrics.EnsureResultError)).To(Equal(otherBefore))
+		})
+	})
+
+	Context("kubevirt_hco_upgrade_patches_total", func() {
+		It("should count the upgrade patches by outcome", func() {
+			before, err := metrics.GetUpgradePatchesCount("Skipped")
+			Expect(err).ToNot(HaveOccurred())
+
+			metrics.IncUpgradePatches("Skipped")
+			metrics.IncUpgradePatches("Skipped")
+
+			Expect(metrics.GetUpgradePatchesCount("Skipped")).To(Equal(before + 2))
+		})
+	})
+	Context("kubevirt_hco_operand_version", func() {
+		It("should report whether the operand is upgraded", func() {
+			metrics.SetOperandVersion("KubeVirt", "kubevirt-kubevirt-hyperconverged", "1.0.0", "1.1.0")
+
+			value, observed, target, found, err := metrics.GetOperandVersion("KubeVirt", "kubevirt-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(BeZero())
+			Expect(observed).To(Equal("1.0.0"))
+			Expect(target).To(Equal("1.1.0"))
+
+			metrics.SetOperandVersion("KubeVirt", "kubevirt-kubevirt-hyperconverged", "1.1.0", "1.1.0")
+
+			value, observed, target, found, err = metrics.GetOperandVersion("KubeVirt", "kubevirt-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(1.0))
+			Expect(observed).To(Equal("1.1.0"))
+			Expect(target).To(Equal("1.1.0"))
+		})
+
+		It("should delete the versions of an operand", func() {
+			metrics.SetOperandVersion("AAQ", "aaq-kubevirt-hyperconverged", "1.0.0", "1.0.0")
+			metrics.DeleteOperandVersion("AAQ", "aaq-kubevirt-hyperconverged")
+
+			_, _, _, found, err := metrics.GetOperandVersion("AAQ", "aaq-kubevirt-hyperconverged")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("kubevirt_hco_upgrade_in_progress", func() {
+		It("should report the upgrade progress", func() {
+			start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+			metrics.SetUpgradeStatus(true, start, time.Time{}, "CDI/cdi-kubevirt-hyperconverged")
+
+			Expect(metrics.IsUpgradeInProgress()).To(BeTrue())
+			Expect(metrics.GetUpgradeStartTime()).To(Equal(float64(start.Unix())))
+
+			operand, found, err := metrics.GetUpgradeBlockingOperand()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(operand).To(Equal("cdi/cdi-kubevirt-hyperconverged"))
+
+			metrics.SetUpgradeStatus(false, start, start.Add(time.Hour), "")
+
+			Expect(metrics.IsUpgradeInProgress()).To(BeFalse())
+			Expect(metrics.GetUpgradeStartTime()).To(Equal(float64(start.Unix())))
+
+			_, found, err = metrics.GetUpgradeBlockingOperand()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
 })
@@ -4,10 +4,13 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"slices"
 	"strings"
 
 	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 )
 
 const (
@@ -24,6 +27,7 @@ func Register(operatorRegistry *operatorrules.Registry) error {
 	alerts := [][]promv1.Rule{
 		operatorAlerts(),
 		healthAlerts(),
+		upgradeAlerts(),
 	}
 
 	runbookURLTemplate := getRunbookURLTemplate()
@@ -39,6 +43,11 @@ func Register(operatorRegistry *operatorrules.Registry) error {
 	return operatorRegistry.RegisterAlerts(alerts...)
 }
 
+// TunableAlerts returns the alerts that can be overridden by the HyperConverged CR
+func TunableAlerts() alertoverrides.TunableAlerts {
+	return alertoverrides.NewTunableAlerts(slices.Concat(operatorAlerts(), healthAlerts(), upgradeAlerts()), upgradeAlertThresholds)
+}
+
 func getRunbookURLTemplate() string {
 	runbookURLTemplate, exists := os.LookupEnv(runbookURLTemplateEnv)
 	if !exists {
@@ -38,5 +38,31 @@ func healthAlerts() []promv1.Rule {
 				healthImpactAlertLabelKey: "warning",
 			},
 		},
+		{
+			Alert: "HCOOperandConditionsUnhealthy",
+			Expr:  intstr.FromString(fmt.Sprintf("kubevirt_hco_operand_health_status == %f", metrics.SystemHealthStatusError)),
+			For:   ptr.To(promv1.Duration("10m")),
+			Annotations: map[string]string{
+				"description": "The {{ $labels.component_name }} operand of HCO is in a critical state; it is either not available or degraded.",
+				"summary":     "The {{ $labels.component_name }} operand of HCO is in a critical state.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "critical",
+				healthImpactAlertLabelKey: "critical",
+			},
+		},
+		{
+			Alert: "HCOOperandConditionsUnhealthy",
+			Expr:  intstr.FromString(fmt.Sprintf("kubevirt_hco_operand_health_status == %f", metrics.SystemHealthStatusWarning)),
+			For:   ptr.To(promv1.Duration("10m")),
+			Annotations: map[string]string{
+				"description": "The {{ $labels.component_name }} operand of HCO is in a warning state; it is progressing.",
+				"summary":     "The {{ $labels.component_name }} operand of HCO is in a warning state.",
+			},
+			Labels: map[string]string{
+				severityAlertLabelKey:     "warning",
+				healthImpactAlertLabelKey: "warning",
+			},
+		},
 	}
 }
@@ -0,0 +1,46 @@
+package alerts
+
+import (
+	"fmt"
+
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
+)
+
+const (
+	upgradeStuckAlert = "HCOUpgradeStuck"
+
+	// the upgrade duration, in minutes
+	defaultUpgradeStuckThreshold = 120
+)
+
+// the tunable thresholds of the upgrade alerts. The upgrade duration is in minutes; up to a week.
+var upgradeAlertThresholds = map[string]*alertoverrides.Threshold{
+	upgradeStuckAlert: {Default: defaultUpgradeStuckThreshold, Min: 10, Max: 10080, Build: upgradeStuck},
+}
+
+func upgradeAlerts() []promv1.Rule {
+	return []promv1.Rule{
+		upgradeStuck(defaultUpgradeStuckThreshold),
+	}
+}
+
+func upgradeStuck(threshold float64) promv1.Rule {
+	return promv1.Rule{
+		Alert: upgradeStuckAlert,
+		Expr: intstr.FromString(fmt.Sprintf(
+			"(time() - kubevirt_hco_upgrade_start_timestamp_seconds) > %s and on() kubevirt_hco_upgrade_in_progress == 1",
+			alertoverrides.FormatFloat(threshold*60),
+		)),
+		Annotations: map[string]string{
+			"description": fmt.Sprintf("The HCO upgrade has been in progress for more than %s minutes. The operand that blocks the upgrade is reported in the status.upgrade.blockingOperand field of the HyperConverged resource, and by the kubevirt_hco_upgrade_blocking_operand metric.", alertoverrides.FormatFloat(threshold)),
+			"summary":     "The HCO upgrade is not completed for a long time.",
+		},
+		Labels: map[string]string{
+			severityAlertLabelKey:     "warning",
+			healthImpactAlertLabelKey: "warning",
+		},
+	}
+}
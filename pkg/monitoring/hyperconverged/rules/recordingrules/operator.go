@@ -25,6 +25,14 @@ var operatorRecordingRules = []operatorrules.RecordingRule{
 		MetricType: operatormetrics.GaugeType,
 		Expr:       buildOperatorHealthStatusExpr(),
 	},
+	{
+		MetricsOpts: operatormetrics.MetricOpts{
+			Name: "kubevirt_hco_operand_health_status",
+			Help: "Indicates whether the health status of each operand of HCO is healthy (0), warning (1) or error (2), based on the kubevirt_hco_operand_condition metric",
+		},
+		MetricType: operatormetrics.GaugeType,
+		Expr:       buildOperandHealthStatusExpr(),
+	},
 	{
 		MetricsOpts: operatormetrics.MetricOpts{
 			Name: "cluster:vmi_request_cpu_cores:sum",
@@ -58,3 +66,25 @@ func buildOperatorHealthStatusExpr() intstr.IntOrString {
 
 	return intstr.FromString("label_replace(" + criticalExpr + " or " + warningExpr + " or " + healthyExpr + `,"name","kubevirt-hyperconverged","","")`)
 }
+
+// buildOperandHealthStatusExpr aggregates the conditions of each operand the same way HCO aggregates its own conditions
+// into the kubevirt_hco_system_health_status metric: an operand that is not available or is degraded is in error, and
+// an operand that is progressing is in warning.
+func buildOperandHealthStatusExpr() intstr.IntOrString {
+	errorExpr := fmt.Sprintf(
+		`(kubevirt_hco_operand_condition{condition="Available"} == %d or kubevirt_hco_operand_condition{condition="Degraded"} == %d) * 0 + %d`,
+		int64(metrics.OperandConditionFalse), int64(metrics.OperandConditionTrue), int64(metrics.SystemHealthStatusError),
+	)
+
+	warningExpr := fmt.Sprintf(
+		`(kubevirt_hco_operand_condition{condition="Progressing"} == %d) * 0 + %d`,
+		int64(metrics.OperandConditionTrue), int64(metrics.SystemHealthStatusWarning),
+	)
+
+	healthyExpr := fmt.Sprintf(
+		`kubevirt_hco_operand_condition{condition="Available"} * 0 + %d`,
+		int64(metrics.SystemHealthStatusHealthy),
+	)
+
+	return intstr.FromString("max by (component_name) (" + errorExpr + " or " + warningExpr + " or " + healthyExpr + ")")
+}
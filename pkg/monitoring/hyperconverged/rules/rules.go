@@ -5,6 +5,7 @@ import (
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/alerts"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules/recordingrules"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -52,3 +53,8 @@ func ListRecordingRules() []operatorrules.RecordingRule {
 func ListAlerts() []promv1.Rule {
 	return operatorRegistry.ListAlerts()
 }
+
+// TunableAlerts returns the alerts of the PrometheusRule that can be overridden by the HyperConverged CR
+func TunableAlerts() alertoverrides.TunableAlerts {
+	return alerts.TunableAlerts()
+}
@@ -34,6 +34,21 @@ var _ = Describe("Rules Validation", func() {
 		Expect(problems).To(BeEmpty())
 	})
 
+	It("Should build the alerts with the default thresholds", func() {
+		tunable := TunableAlerts()
+
+		for _, alert := range ListAlerts() {
+			Expect(tunable).To(HaveKey(alert.Alert))
+
+			threshold := tunable[alert.Alert]
+			if threshold == nil {
+				continue
+			}
+
+			Expect(threshold.Build(threshold.Default).Expr).To(Equal(alert.Expr), "alert %s", alert.Alert)
+		}
+	})
+
 	It("Should validate recording rules", func() {
 		recordingRules := ListRecordingRules()
 		problems := linter.LintRecordingRules(recordingRules)
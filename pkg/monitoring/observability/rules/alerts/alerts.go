@@ -8,6 +8,8 @@ import (
 
 	"github.com/machadovilaca/operator-observability/pkg/operatorrules"
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 )
 
 const (
@@ -37,6 +39,11 @@ func Register(operatorRegistry *operatorrules.Registry) error {
 	return operatorRegistry.RegisterAlerts(alerts...)
 }
 
+// TunableAlerts returns the alerts that can be overridden by the HyperConverged CR
+func TunableAlerts() alertoverrides.TunableAlerts {
+	return alertoverrides.NewTunableAlerts(clusterAlerts(), clusterAlertThresholds)
+}
+
 func getRunbookURLTemplate() (string, error) {
 	runbookURLTemplate, exists := os.LookupEnv(runbookURLTemplateEnv)
 	if !exists {
@@ -7,6 +7,8 @@ import (
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 )
 
 // Network interface flag bitmasks for Linux interface flags
@@ -17,6 +19,23 @@ const (
 	IFF_LOWER_UP = 65536
 )
 
+const (
+	highCPUWorkloadAlert           = "HighCPUWorkload"
+	persistentVolumeFillingUpAlert = "PersistentVolumeFillingUp"
+	highNodeCPUFrequencyAlert      = "HighNodeCPUFrequency"
+
+	defaultHighCPUWorkloadThreshold           = 0.9
+	defaultPersistentVolumeFillingUpThreshold = 0.1
+	defaultHighNodeCPUFrequencyThreshold      = 0.8
+)
+
+// the tunable thresholds of the cluster alerts. All of them are ratios.
+var clusterAlertThresholds = map[string]*alertoverrides.Threshold{
+	highCPUWorkloadAlert:           {Default: defaultHighCPUWorkloadThreshold, Min: 0.01, Max: 1, Build: highCPUWorkload},
+	persistentVolumeFillingUpAlert: {Default: defaultPersistentVolumeFillingUpThreshold, Min: 0.01, Max: 1, Build: persistentVolumeFillingUp},
+	highNodeCPUFrequencyAlert:      {Default: defaultHighNodeCPUFrequencyThreshold, Min: 0.01, Max: 1, Build: highNodeCPUFrequency},
+}
+
 var ignoredInterfacesForNetworkDown = []string{
 	"lo",          // loopback interface
 	"tunbr",       // tunnel bridge
@@ -28,26 +47,14 @@ var ignoredInterfacesForNetworkDown = []string{
 
 func clusterAlerts() []promv1.Rule {
 	return []promv1.Rule{
-		{
-			Alert: "HighCPUWorkload",
-			Expr:  intstr.FromString("instance:node_cpu_utilisation:rate1m >= 0.9"),
-			For:   ptr.To[promv1.Duration]("5m"),
-			Annotations: map[string]string{
-				"summary":     "High CPU usage on host {{ $labels.instance }}",
-				"description": "CPU utilization for {{ $labels.instance }} has been above 90% for more than 5 minutes.",
-			},
-			Labels: map[string]string{
-				"severity":               "warning",
-				"operator_health_impact": "none",
-			},
-		},
+		highCPUWorkload(defaultHighCPUWorkloadThreshold),
 		{
 			Alert: "HAControlPlaneDown",
 			Expr:  intstr.FromString("kube_node_role{role='control-plane'} * on(node) kube_node_status_condition{condition='Ready',status='true'} == 0"),
 			For:   ptr.To[promv1.Duration]("5m"),
 			Annotations: map[string]string{
 				"summary":     "Control plane node {{ $labels.node }} is not ready",
-				"description": "Control plane node {{ $labels.node }} has been not ready for more than 5 minutes.",
+				"description": "Control plane node {{ $labels.node }} is not ready.",
 			},
 			Labels: map[string]string{
 				"severity":               "critical",
@@ -70,51 +77,75 @@ func clusterAlerts() []promv1.Rule {
 			For: ptr.To[promv1.Duration]("5m"),
 			Annotations: map[string]string{
 				"summary":     "Network interfaces are down",
-				"description": "{{ $value }} network devices have been down on instance {{ $labels.instance }} for more than 5 minutes.",
+				"description": "{{ $value }} network devices are down on instance {{ $labels.instance }}.",
 			},
 			Labels: map[string]string{
 				"severity":               "warning",
 				"operator_health_impact": "none",
 			},
 		},
-		{
-			Alert: "PersistentVolumeFillingUp",
-			Expr: intstr.FromString(`
+		persistentVolumeFillingUp(defaultPersistentVolumeFillingUpThreshold),
+		highNodeCPUFrequency(defaultHighNodeCPUFrequencyThreshold),
+	}
+}
+
+func highCPUWorkload(threshold float64) promv1.Rule {
+	return promv1.Rule{
+		Alert: highCPUWorkloadAlert,
+		Expr:  intstr.FromString("instance:node_cpu_utilisation:rate1m >= " + alertoverrides.FormatFloat(threshold)),
+		For:   ptr.To[promv1.Duration]("5m"),
+		Annotations: map[string]string{
+			"summary":     "High CPU usage on host {{ $labels.instance }}",
+			"description": fmt.Sprintf("CPU utilization for {{ $labels.instance }} is above %s.", alertoverrides.FormatPercentage(threshold)),
+		},
+		Labels: map[string]string{
+			"severity":               "warning",
+			"operator_health_impact": "none",
+		},
+	}
+}
+
+func persistentVolumeFillingUp(threshold float64) promv1.Rule {
+	return promv1.Rule{
+		Alert: persistentVolumeFillingUpAlert,
+		Expr: intstr.FromString(fmt.Sprintf(`
 				(
 					kubelet_volume_stats_available_bytes{job="kubelet",metrics_path="/metrics"}
 					/
 					kubelet_volume_stats_capacity_bytes{job="kubelet",metrics_path="/metrics"}
-				) < 0.10
+				) < %s
 				and kubelet_volume_stats_used_bytes{job="kubelet",metrics_path="/metrics"} > 0
 				and predict_linear(kubelet_volume_stats_available_bytes{job="kubelet",metrics_path="/metrics"}[6h], 4 * 24 * 3600) < 0
 				unless on (cluster, namespace, persistentvolumeclaim) kube_persistentvolumeclaim_access_mode{access_mode="ReadOnlyMany"} == 1
-			`),
-			For: ptr.To[promv1.Duration]("5m"),
-			Annotations: map[string]string{
-				"summary":     "PersistentVolume is filling up",
-				"description": "Based on recent sampling, the PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} is expected to fill up within four days. Currently {{ $value | humanizePercentage }} is available.",
-			},
-			Labels: map[string]string{
-				"severity":               "warning",
-				"operator_health_impact": "none",
-			},
+			`, alertoverrides.FormatFloat(threshold))),
+		For: ptr.To[promv1.Duration]("5m"),
+		Annotations: map[string]string{
+			"summary":     "PersistentVolume is filling up",
+			"description": "Based on recent sampling, the PersistentVolume claimed by {{ $labels.persistentvolumeclaim }} in Namespace {{ $labels.namespace }} is expected to fill up within four days. Currently {{ $value | humanizePercentage }} is available.",
 		},
-		{
-			Alert: "HighNodeCPUFrequency",
-			Expr: intstr.FromString(`
+		Labels: map[string]string{
+			"severity":               "warning",
+			"operator_health_impact": "none",
+		},
+	}
+}
+
+func highNodeCPUFrequency(threshold float64) promv1.Rule {
+	return promv1.Rule{
+		Alert: highNodeCPUFrequencyAlert,
+		Expr: intstr.FromString(fmt.Sprintf(`
 				node_cpu_frequency_hertz > 0
 				and on(instance, cpu)
-				node_cpu_frequency_hertz - on(instance, cpu) group_left() node_cpu_frequency_max_hertz * 0.8 > 0
-			`),
-			For: ptr.To[promv1.Duration]("5m"),
-			Annotations: map[string]string{
-				"summary":     "High CPU frequency detected on node {{ $labels.instance }}",
-				"description": "CPU frequency on node {{ $labels.instance }} (CPU {{ $labels.cpu }}) is {{ $value | humanize }}Hz, which is above 80% of the maximum frequency. This may indicate high CPU utilization or thermal throttling.",
-			},
-			Labels: map[string]string{
-				"severity":               "warning",
-				"operator_health_impact": "none",
-			},
+				node_cpu_frequency_hertz - on(instance, cpu) group_left() node_cpu_frequency_max_hertz * %s > 0
+			`, alertoverrides.FormatFloat(threshold))),
+		For: ptr.To[promv1.Duration]("5m"),
+		Annotations: map[string]string{
+			"summary":     "High CPU frequency detected on node {{ $labels.instance }}",
+			"description": fmt.Sprintf("CPU frequency on node {{ $labels.instance }} (CPU {{ $labels.cpu }}) is {{ $value | humanize }}Hz, which is above %s of the maximum frequency. This may indicate high CPU utilization or thermal throttling.", alertoverrides.FormatPercentage(threshold)),
+		},
+		Labels: map[string]string{
+			"severity":               "warning",
+			"operator_health_impact": "none",
 		},
 	}
 }
@@ -7,6 +7,7 @@ import (
 	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules/alerts"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -37,3 +38,8 @@ func BuildPrometheusRule(namespace string, owner *metav1.OwnerReference) (*promv
 func ListAlerts() []promv1.Rule {
 	return operatorRegistry.ListAlerts()
 }
+
+// TunableAlerts returns the alerts of the PrometheusRule that can be overridden by the HyperConverged CR
+func TunableAlerts() alertoverrides.TunableAlerts {
+	return alerts.TunableAlerts()
+}
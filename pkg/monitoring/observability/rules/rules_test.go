@@ -33,4 +33,18 @@ var _ = Describe("Rules Validation", func() {
 		problems := linter.LintAlerts(alerts)
 		Expect(problems).To(BeEmpty())
 	})
+
+	It("Should build the alerts with the default thresholds", func() {
+		tunable := TunableAlerts()
+		Expect(tunable).To(HaveLen(len(ListAlerts())))
+
+		for _, alert := range ListAlerts() {
+			threshold := tunable[alert.Alert]
+			if threshold == nil {
+				continue
+			}
+
+			Expect(threshold.Build(threshold.Default).Expr).To(Equal(alert.Expr), "alert %s", alert.Alert)
+		}
+	})
 })
@@ -11,4 +11,8 @@ var (
 
 	GetControlPlaneArchitectures = internal.GetControlPlaneArchitectures
 	GetWorkloadsArchitectures    = internal.GetWorkloadsArchitectures
+
+	ProposeNodePlacement = internal.ProposeNodePlacement
 )
+
+type NodePlacementProposal = internal.NodePlacementProposal
@@ -0,0 +1,158 @@
+package upgradepatch
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"reflect"
+	"slices"
+	"strings"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+// ClusterFacts holds the cluster information the upgrade patch preconditions are evaluated against
+type ClusterFacts struct {
+	// IsOpenshift is true if the cluster is an OpenShift cluster
+	IsOpenshift bool
+	// ControlPlaneArchitectures is the list of the CPU architectures of the control-plane nodes
+	ControlPlaneArchitectures []string
+	// WorkloadsArchitectures is the list of the CPU architectures of the workloads nodes
+	WorkloadsArchitectures []string
+	// CRDExists checks if a CustomResourceDefinition, with the given name, is deployed in the cluster
+	CRDExists func(crdName string) (bool, error)
+}
+
+type patchPreconditions struct {
+	// IsOpenshift, if set, requires the cluster to be (true) or not to be (false) an OpenShift cluster.
+	IsOpenshift *bool `json:"isOpenshift,omitempty"`
+	// FeatureGates maps HyperConverged feature gate names to the value they must have in the HyperConverged CR.
+	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+	// CRDsExist is a list of CustomResourceDefinition names that must be deployed in the cluster.
+	CRDsExist []string `json:"crdsExist,omitempty"`
+	// ControlPlaneArchitectures, if not empty, requires at least one of the control-plane nodes to have one of
+	// the listed CPU architectures.
+	ControlPlaneArchitectures []string `json:"controlPlaneArchitectures,omitempty"`
+	// WorkloadsArchitectures, if not empty, requires at least one of the workloads nodes to have one of the listed
+	// CPU architectures.
+	WorkloadsArchitectures []string `json:"workloadsArchitectures,omitempty"`
+}
+
+// check returns an empty string if all the preconditions are met, or a message describing the first unmet
+// precondition otherwise.
+func (pc *patchPreconditions) check(hcoJSON []byte, facts ClusterFacts) (string, error) {
+	if pc == nil {
+		return "", nil
+	}
+
+	if pc.IsOpenshift != nil && *pc.IsOpenshift != facts.IsOpenshift {
+		if *pc.IsOpenshift {
+			return "the cluster is not an OpenShift cluster", nil
+		}
+		return "the cluster is an OpenShift cluster", nil
+	}
+
+	if len(pc.FeatureGates) > 0 {
+		fgs, err := getFeatureGates(hcoJSON)
+		if err != nil {
+			return "", err
+		}
+
+		for _, name := range slices.Sorted(maps.Keys(pc.FeatureGates)) {
+			if fgs[name] != pc.FeatureGates[name] {
+				return fmt.Sprintf("the %s feature gate is not %t", name, pc.FeatureGates[name]), nil
+			}
+		}
+	}
+
+	for _, crdName := range pc.CRDsExist {
+		if facts.CRDExists == nil {
+			return "", errors.New("can't check if CRDs exist")
+		}
+
+		exists, err := facts.CRDExists(crdName)
+		if err != nil {
+			return "", err
+		}
+
+		if !exists {
+			return fmt.Sprintf("the %s CRD does not exist", crdName), nil
+		}
+	}
+
+	if len(pc.ControlPlaneArchitectures) > 0 && !containsAny(pc.ControlPlaneArchitectures, facts.ControlPlaneArchitectures) {
+		return fmt.Sprintf("no control-plane node with any of the %v architectures", pc.ControlPlaneArchitectures), nil
+	}
+
+	if len(pc.WorkloadsArchitectures) > 0 && !containsAny(pc.WorkloadsArchitectures, facts.WorkloadsArchitectures) {
+		return fmt.Sprintf("no workloads node with any of the %v architectures", pc.WorkloadsArchitectures), nil
+	}
+
+	return "", nil
+}
+
+func (pc *patchPreconditions) validate() error {
+	if pc == nil {
+		return nil
+	}
+
+	knownFGs := getKnownFeatureGates()
+	for name := range pc.FeatureGates {
+		if !slices.Contains(knownFGs, name) {
+			return fmt.Errorf("unknown feature gate %q in preconditions", name)
+		}
+	}
+
+	for _, crdName := range pc.CRDsExist {
+		if crdName == "" {
+			return errors.New("empty CRD name in preconditions")
+		}
+	}
+
+	for _, arch := range slices.Concat(pc.ControlPlaneArchitectures, pc.WorkloadsArchitectures) {
+		if arch == "" {
+			return errors.New("empty architecture in preconditions")
+		}
+	}
+
+	return nil
+}
+
+func getFeatureGates(hcoJSON []byte) (map[string]bool, error) {
+	hc := struct {
+		Spec struct {
+			FeatureGates map[string]*bool `json:"featureGates,omitempty"`
+		} `json:"spec"`
+	}{}
+
+	if err := json.Unmarshal(hcoJSON, &hc); err != nil {
+		return nil, err
+	}
+
+	fgs := make(map[string]bool, len(hc.Spec.FeatureGates))
+	for name, value := range hc.Spec.FeatureGates {
+		fgs[name] = value != nil && *value
+	}
+
+	return fgs, nil
+}
+
+func getKnownFeatureGates() []string {
+	fgType := reflect.TypeFor[v1beta1.HyperConvergedFeatureGates]()
+	names := make([]string, 0, fgType.NumField())
+	for i := range fgType.NumField() {
+		name, _, _ := strings.Cut(fgType.Field(i).Tag.Get("json"), ",")
+		if name != "" && name != "-" {
+			names = append(names, name)
+		}
+	}
+
+	return names
+}
+
+func containsAny(required, actual []string) bool {
+	return slices.ContainsFunc(actual, func(arch string) bool {
+		return slices.Contains(required, arch)
+	})
+}
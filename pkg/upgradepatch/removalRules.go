@@ -0,0 +1,76 @@
+package upgradepatch
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+type ObjectsRemovalRule struct {
+	// SemverRange is a set of conditions which specify which versions satisfy the range
+	// (see https://github.com/blang/semver#ranges as a reference).
+	SemverRange semverRange `json:"semverRange"`
+	// GroupVersionKind unambiguously identifies the kind of the objects to be removed
+	GroupVersionKind schema.GroupVersionKind `json:"groupVersionKind"`
+	// Namespaces is the list of the namespaces to look for the objects in. All the namespaces are searched if empty.
+	// Ignored for cluster scoped kinds.
+	Namespaces []string `json:"namespaces,omitempty"`
+	// LabelSelector selects the objects to be removed, by their labels.
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// NamePrefix selects the objects to be removed, by the prefix of their names.
+	NamePrefix string `json:"namePrefix,omitempty"`
+	// IncludeNonHCOObjects allows removing matching objects that were not deployed by HCO. By default, only objects
+	// with the HCO app label are removed.
+	IncludeNonHCOObjects bool `json:"includeNonHCOObjects,omitempty"`
+	// DryRun, if true, only logs the matching objects; they are sent to the API server in a dry-run delete request,
+	// but not actually removed.
+	DryRun bool `json:"dryRun,omitempty"`
+}
+
+func (r ObjectsRemovalRule) IsAffectedRange(ver semver.Version) bool {
+	return r.SemverRange.isAffectedRange(ver)
+}
+
+// GetSelector returns the label selector of the rule; labels.Everything() if the rule has no label selector.
+func (r ObjectsRemovalRule) GetSelector() (labels.Selector, error) {
+	if r.LabelSelector == nil {
+		return labels.Everything(), nil
+	}
+
+	return metav1.LabelSelectorAsSelector(r.LabelSelector)
+}
+
+// MatchName checks if the object name matches the name prefix of the rule.
+func (r ObjectsRemovalRule) MatchName(name string) bool {
+	return strings.HasPrefix(name, r.NamePrefix)
+}
+
+func validateRemovalRule(r ObjectsRemovalRule) error {
+	if r.GroupVersionKind.Kind == "" {
+		return errors.New("missing object kind")
+	}
+	if r.GroupVersionKind.Version == "" {
+		return errors.New("missing object API version")
+	}
+
+	// never allow a rule that matches all the objects of a kind
+	if (r.LabelSelector == nil || (len(r.LabelSelector.MatchLabels) == 0 && len(r.LabelSelector.MatchExpressions) == 0)) && r.NamePrefix == "" {
+		return errors.New("removal rule must have a label selector or a name prefix")
+	}
+
+	if _, err := r.GetSelector(); err != nil {
+		return err
+	}
+
+	for _, ns := range r.Namespaces {
+		if ns == "" {
+			return errors.New("empty namespace in removal rule")
+		}
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	_ "embed"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"os"
 	"strings"
 	"sync"
@@ -30,6 +31,9 @@ type hcoCRPatch struct {
 	// SemverRange is a set of conditions which specify which versions satisfy the range
 	// (see https://github.com/blang/semver#ranges as a reference).
 	SemverRange semverRange `json:"semverRange"`
+	// Preconditions are optional cluster facts that must be true, in addition to the semver range, for the patch
+	// to be applied.
+	Preconditions *patchPreconditions `json:"preconditions,omitempty"`
 	// JSONPatch contains a sequence of operations to apply to the HCO CR during upgrades
 	// (see: https://datatracker.ietf.org/doc/html/rfc6902 as the format reference).
 	JSONPatch jsonpatch.Patch `json:"jsonPatch"`
@@ -39,34 +43,46 @@ type hcoCRPatch struct {
 	JSONPatchApplyOptions *jsonpatch.ApplyOptions `json:"jsonPatchApplyOptions,omitempty"`
 }
 
-func (p hcoCRPatch) applyUpgradePatch(logger logr.Logger, hcoJSON []byte, knownHcoSV semver.Version) ([]byte, error) {
-	if p.IsAffectedRange(knownHcoSV) {
-		buff := &bytes.Buffer{}
-		err := json.NewEncoder(buff).Encode(p.JSONPatch)
-		if err != nil {
-			buff = bytes.NewBuffer([]byte("<unknown>"))
-		}
+func (p hcoCRPatch) applyUpgradePatch(logger logr.Logger, hcoJSON []byte, knownHcoSV semver.Version, facts ClusterFacts) ([]byte, v1beta1.UpgradePatchOutcome, string, error) {
+	if !p.IsAffectedRange(knownHcoSV) {
+		return hcoJSON, v1beta1.UpgradePatchSkipped, fmt.Sprintf("version %s is not in the patch semver range", knownHcoSV), nil
+	}
 
-		logger.Info("applying upgrade patch", "knownHcoSV", knownHcoSV, "affectedRange", p.SemverRange.ver, "patches", buff.String(), "applyOptions", p.JSONPatchApplyOptions)
-		var (
-			patchedBytes []byte
-		)
-		if p.JSONPatchApplyOptions != nil {
-			patchedBytes, err = p.JSONPatch.ApplyWithOptions(hcoJSON, p.JSONPatchApplyOptions)
-		} else {
-			patchedBytes, err = p.JSONPatch.Apply(hcoJSON)
-		}
-		if err != nil {
-			// tolerate jsonpatch test failures
-			if errors.Is(err, jsonpatch.ErrTestFailed) {
-				return hcoJSON, nil
-			}
+	unmet, err := p.Preconditions.check(hcoJSON, facts)
+	if err != nil {
+		return hcoJSON, "", "", err
+	}
+
+	if unmet != "" {
+		logger.Info("skipping upgrade patch; precondition is not met", "affectedRange", p.SemverRange.ver, "reason", unmet)
+		return hcoJSON, v1beta1.UpgradePatchSkipped, unmet, nil
+	}
 
-			return hcoJSON, err
+	buff := &bytes.Buffer{}
+	err = json.NewEncoder(buff).Encode(p.JSONPatch)
+	if err != nil {
+		buff = bytes.NewBuffer([]byte("<unknown>"))
+	}
+
+	logger.Info("applying upgrade patch", "knownHcoSV", knownHcoSV, "affectedRange", p.SemverRange.ver, "patches", buff.String(), "applyOptions", p.JSONPatchApplyOptions)
+	var (
+		patchedBytes []byte
+	)
+	if p.JSONPatchApplyOptions != nil {
+		patchedBytes, err = p.JSONPatch.ApplyWithOptions(hcoJSON, p.JSONPatchApplyOptions)
+	} else {
+		patchedBytes, err = p.JSONPatch.Apply(hcoJSON)
+	}
+	if err != nil {
+		// tolerate jsonpatch test failures, but report them
+		if errors.Is(err, jsonpatch.ErrTestFailed) {
+			logger.Info("upgrade patch was not applied; a test operation failed", "affectedRange", p.SemverRange.ver, "error", err.Error())
+			return hcoJSON, v1beta1.UpgradePatchTestFailed, err.Error(), nil
 		}
-		return patchedBytes, nil
+
+		return hcoJSON, "", "", err
 	}
-	return hcoJSON, nil
+	return patchedBytes, v1beta1.UpgradePatchApplied, "", nil
 }
 
 func (p hcoCRPatch) IsAffectedRange(ver semver.Version) bool {
@@ -142,28 +158,46 @@ type UpgradePatches struct {
 	// ObjectsToBeRemoved is a list of objects to be removed on upgrades.
 	// Each objectToBeRemoved consists in a semver range of affected source versions and schema.GroupVersionKind and types.NamespacedName of the object to be eventually removed during the upgrade.
 	ObjectsToBeRemoved []ObjectToBeRemoved `json:"objectsToBeRemoved"`
+	// ObjectsRemovalRules is a list of rules for removing objects on upgrades.
+	// Each rule consists in a semver range of affected source versions, the kind of the objects to be removed, and a label selector and/or a name prefix to match the objects by, in the given namespaces or in all of them.
+	ObjectsRemovalRules []ObjectsRemovalRule `json:"objectsRemovalRules,omitempty"`
 }
 
-func (up UpgradePatches) applyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) (*v1beta1.HyperConverged, error) {
+// ApplyUpgradePatch applies the relevant upgrade patches from the list to a copy of the HyperConverged CR, and returns
+// the patched copy, together with the outcome of each one of the upgrade patches.
+func (up UpgradePatches) ApplyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version, facts ClusterFacts) (*v1beta1.HyperConverged, []v1beta1.UpgradePatchResult, error) {
 	hcoJSON, err := json.Marshal(hc)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	for _, patch := range up.HCOCRPatchList {
-		hcoJSON, err = patch.applyUpgradePatch(logger, hcoJSON, knownHcoSV)
+	results := make([]v1beta1.UpgradePatchResult, 0, len(up.HCOCRPatchList))
+	for i, patch := range up.HCOCRPatchList {
+		var (
+			outcome v1beta1.UpgradePatchOutcome
+			message string
+		)
+
+		hcoJSON, outcome, message, err = patch.applyUpgradePatch(logger, hcoJSON, knownHcoSV, facts)
 		if err != nil {
-			return nil, err
+			return nil, nil, err
 		}
+
+		results = append(results, v1beta1.UpgradePatchResult{
+			Index:       int32(i),
+			SemverRange: patch.SemverRange.ver,
+			Outcome:     outcome,
+			Message:     message,
+		})
 	}
 
 	tmpInstance := &v1beta1.HyperConverged{}
 	err = json.Unmarshal(hcoJSON, tmpInstance)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return tmpInstance, nil
+	return tmpInstance, results, nil
 }
 
 var (
@@ -172,14 +206,20 @@ var (
 	onceErr           error
 )
 
-func ApplyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version) (*v1beta1.HyperConverged, error) {
-	return hcoUpgradeChanges.applyUpgradePatch(logger, hc, knownHcoSV)
+// ApplyUpgradePatch applies the relevant upgrade patches to a copy of the HyperConverged CR, and returns the
+// patched copy, together with the outcome of each one of the upgrade patches.
+func ApplyUpgradePatch(logger logr.Logger, hc *v1beta1.HyperConverged, knownHcoSV semver.Version, facts ClusterFacts) (*v1beta1.HyperConverged, []v1beta1.UpgradePatchResult, error) {
+	return hcoUpgradeChanges.ApplyUpgradePatch(logger, hc, knownHcoSV, facts)
 }
 
 func GetObjectsToBeRemoved() []ObjectToBeRemoved {
 	return hcoUpgradeChanges.ObjectsToBeRemoved
 }
 
+var GetObjectsRemovalRules = func() []ObjectsRemovalRule {
+	return hcoUpgradeChanges.ObjectsRemovalRules
+}
+
 var getUpgradeChangesFileLocation = func() string {
 	return upgradeChangesFileLocation
 }
@@ -188,21 +228,49 @@ func readUpgradePatchesFromFile(logger logr.Logger) error {
 	hcoUpgradeChanges = UpgradePatches{}
 	fileLocation := getUpgradeChangesFileLocation()
 
-	file, err := os.Open(fileLocation)
+	upgradeChanges, err := decodeUpgradePatchesFile(fileLocation)
 	if err != nil {
-		logger.Error(err, "Can't open the upgradeChanges yaml file", "file name", fileLocation)
+		logger.Error(err, "Can't read the upgradeChanges json file", "file name", fileLocation)
 		return err
 	}
 
+	hcoUpgradeChanges = *upgradeChanges
+
+	return nil
+}
+
+func decodeUpgradePatchesFile(fileLocation string) (*UpgradePatches, error) {
+	file, err := os.Open(fileLocation)
+	if err != nil {
+		return nil, err
+	}
+
 	defer file.Close()
 
+	upgradeChanges := &UpgradePatches{}
 	jDec := json.NewDecoder(file)
-	err = jDec.Decode(&hcoUpgradeChanges)
+	err = jDec.Decode(upgradeChanges)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	return nil
+	return upgradeChanges, nil
+}
+
+// ReadUpgradePatches reads and validates the upgrade patches from a file in an arbitrary location. Unlike Init, it
+// does not affect the upgrade patches used by the operator. It is used by tools that work with upgrade patch
+// files offline.
+func ReadUpgradePatches(fileLocation string) (*UpgradePatches, error) {
+	upgradeChanges, err := decodeUpgradePatchesFile(fileLocation)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = upgradeChanges.validate(); err != nil {
+		return nil, err
+	}
+
+	return upgradeChanges, nil
 }
 
 func Init(logger logr.Logger) error {
@@ -214,20 +282,33 @@ func Init(logger logr.Logger) error {
 		return onceErr
 	}
 
-	for _, p := range hcoUpgradeChanges.HCOCRPatchList {
+	return hcoUpgradeChanges.validate()
+}
+
+func (up UpgradePatches) validate() error {
+	for _, p := range up.HCOCRPatchList {
 		if err := validateUpgradePatch(p); err != nil {
 			return err
 		}
 	}
-	for _, r := range hcoUpgradeChanges.ObjectsToBeRemoved {
+	for _, r := range up.ObjectsToBeRemoved {
 		if err := validateUpgradeLeftover(r); err != nil {
 			return err
 		}
 	}
+	for _, r := range up.ObjectsRemovalRules {
+		if err := validateRemovalRule(r); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
 func validateUpgradePatch(p hcoCRPatch) error {
+	if err := p.Preconditions.validate(); err != nil {
+		return err
+	}
+
 	for _, patch := range p.JSONPatch {
 		path, err := patch.Path()
 		if err != nil {
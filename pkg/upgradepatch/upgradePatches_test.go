@@ -1,6 +1,8 @@
 package upgradepatch
 
 import (
+	"encoding/json"
+	"errors"
 	"os"
 	"path"
 	"slices"
@@ -12,8 +14,11 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/types"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/utils/ptr"
 
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/components"
 )
@@ -140,6 +145,28 @@ var _ = Describe("upgradePatches", func() {
 				),
 			)
 
+			DescribeTable(
+				"should fail validating upgradePatches with bad preconditions",
+				func(filename, message string) {
+					Expect(copyTestFile(filename)).To(Succeed())
+					Expect(Init(GinkgoLogr)).To(MatchError(HavePrefix(message)))
+				},
+				Entry(
+					"unknown feature gate",
+					"badPreconditions1.json",
+					`unknown feature gate "badUnexistingFeatureGate" in preconditions`,
+				),
+				Entry(
+					"empty CRD name",
+					"badPreconditions2.json",
+					"empty CRD name in preconditions",
+				),
+				Entry(
+					"empty architecture",
+					"badPreconditions3.json",
+					"empty architecture in preconditions",
+				),
+			)
 		})
 
 		Context("objectsToBeRemoved", func() {
@@ -189,6 +216,92 @@ var _ = Describe("upgradePatches", func() {
 
 		})
 
+		Context("objectsRemovalRules", func() {
+
+			DescribeTable(
+				"should fail validating upgradePatches with bad removal rules",
+				func(filename, message string) {
+					Expect(copyTestFile(filename)).To(Succeed())
+					Expect(Init(GinkgoLogr)).To(MatchError(HavePrefix(message)))
+				},
+				Entry(
+					"empty object kind",
+					"badRemovalRule1.json",
+					"missing object kind",
+				),
+				Entry(
+					"empty object API version",
+					"badRemovalRule2.json",
+					"missing object API version",
+				),
+				Entry(
+					"empty label selector and no name prefix",
+					"badRemovalRule3.json",
+					"removal rule must have a label selector or a name prefix",
+				),
+				Entry(
+					"wrong label selector",
+					"badRemovalRule4.json",
+					`"Unknown" is not a valid label selector operator`,
+				),
+				Entry(
+					"empty namespace",
+					"badRemovalRule5.json",
+					"empty namespace in removal rule",
+				),
+			)
+
+			It("should have the permissions to remove the objects of the shipped removal rules", func() {
+				shipped, err := ReadUpgradePatches(path.Join("..", "..", "assets", "upgradePatches.json"))
+				Expect(err).ToNot(HaveOccurred())
+
+				permissions := components.GetClusterPermissions()
+				for _, rule := range shipped.ObjectsRemovalRules {
+					resource, _ := meta.UnsafeGuessKindToResource(rule.GroupVersionKind)
+					for _, verb := range []string{"list", "delete"} {
+						Expect(slices.ContainsFunc(permissions, func(p rbacv1.PolicyRule) bool {
+							return slices.Contains(p.APIGroups, resource.Group) &&
+								(slices.Contains(p.Resources, resource.Resource) || slices.Contains(p.Resources, "*")) &&
+								(slices.Contains(p.Verbs, verb) || slices.Contains(p.Verbs, "*"))
+						})).To(BeTrue(), "HCO can't %s %s", verb, resource.String())
+					}
+				}
+			})
+
+			DescribeTable("check MatchName", func(prefix, name string, m types.GomegaMatcher) {
+				rule := ObjectsRemovalRule{NamePrefix: prefix}
+				Expect(rule.MatchName(name)).To(m)
+			},
+				Entry("matching prefix", "v2v-", "v2v-vmware", BeTrue()),
+				Entry("not matching prefix", "v2v-", "vmware-v2v", BeFalse()),
+				Entry("empty prefix", "", "vmware-v2v", BeTrue()),
+			)
+		})
+
+	})
+
+	Context("ReadUpgradePatches", func() {
+		It("should read and validate an upgrade patches file without changing the operator's patches", func() {
+			Expect(Init(GinkgoLogr)).To(Succeed())
+			origPatchesLen := len(hcoUpgradeChanges.HCOCRPatchList)
+			Expect(origPatchesLen).To(BeNumerically(">", 1))
+
+			patches, err := ReadUpgradePatches(path.Join(getTestFilesLocation(), "badPatches5.json"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(patches.HCOCRPatchList).To(HaveLen(1))
+
+			Expect(hcoUpgradeChanges.HCOCRPatchList).To(HaveLen(origPatchesLen))
+		})
+
+		It("should fail reading an invalid upgrade patches file", func() {
+			_, err := ReadUpgradePatches(path.Join(getTestFilesLocation(), "badPatches2.json"))
+			Expect(err).To(MatchError("can only modify spec fields"))
+		})
+
+		It("should fail reading a missing upgrade patches file", func() {
+			_, err := ReadUpgradePatches(path.Join(getTestFilesLocation(), "missing.json"))
+			Expect(err).To(MatchError(os.ErrNotExist))
+		})
 	})
 
 	Context("check semverRange type", func() {
@@ -228,7 +341,7 @@ var _ = Describe("upgradePatches", func() {
 			ver, err := semver.Parse("1.13.9")
 			Expect(err).NotTo(HaveOccurred())
 
-			newHc, err := ApplyUpgradePatch(GinkgoLogr, hc, ver)
+			newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, ver, ClusterFacts{})
 			Expect(err).NotTo(HaveOccurred())
 
 			Expect(newHc.Spec.FeatureGates.DeployKubevirtIpamController).To(BeNil())
@@ -245,7 +358,7 @@ var _ = Describe("upgradePatches", func() {
 				hc.Spec.FeatureGates.EnableCommonBootImageImport = oldFG
 				hc.Spec.EnableCommonBootImageImport = newFG
 
-				newHc, err := ApplyUpgradePatch(GinkgoLogr, hc, ver)
+				newHc, _, err := ApplyUpgradePatch(GinkgoLogr, hc, ver, ClusterFacts{})
 				Expect(err).NotTo(HaveOccurred())
 
 				Expect(newHc.Spec.EnableCommonBootImageImport).To(assertField)
@@ -283,6 +396,110 @@ var _ = Describe("upgradePatches", func() {
 	})
 })
 
+var _ = Describe("upgrade patch preconditions and outcomes", func() {
+	const patchesJSON = `{
+  "hcoCRPatchList": [
+    {
+      "semverRange": "<1.2.0",
+      "jsonPatch": [{"op": "replace", "path": "/spec/featureGates/downwardMetrics", "value": true}]
+    },
+    {
+      "semverRange": ">=1.2.0",
+      "preconditions": {
+        "isOpenshift": true,
+        "featureGates": {"downwardMetrics": false},
+        "crdsExist": ["something.example.com"],
+        "workloadsArchitectures": ["arm64"]
+      },
+      "jsonPatch": [{"op": "replace", "path": "/spec/featureGates/downwardMetrics", "value": true}]
+    },
+    {
+      "semverRange": ">=1.2.0",
+      "jsonPatch": [
+        {"op": "test", "path": "/spec/featureGates/alignCPUs", "value": true},
+        {"op": "replace", "path": "/spec/featureGates/alignCPUs", "value": false}
+      ]
+    }
+  ]
+}`
+
+	var (
+		patches UpgradePatches
+		facts   ClusterFacts
+		ver     = semver.MustParse("1.2.3")
+	)
+
+	BeforeEach(func() {
+		patches = UpgradePatches{}
+		Expect(json.Unmarshal([]byte(patchesJSON), &patches)).To(Succeed())
+
+		facts = ClusterFacts{
+			IsOpenshift:            true,
+			WorkloadsArchitectures: []string{"amd64", "arm64"},
+			CRDExists: func(crdName string) (bool, error) {
+				return crdName == "something.example.com", nil
+			},
+		}
+	})
+
+	It("should apply the patch if all the preconditions are met, and report all the outcomes", func() {
+		hc := components.GetOperatorCR()
+
+		newHc, results, err := patches.ApplyUpgradePatch(GinkgoLogr, hc, ver, facts)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(newHc.Spec.FeatureGates.DownwardMetrics).To(HaveValue(BeTrue()))
+		Expect(results).To(HaveLen(3))
+
+		Expect(results[0].Index).To(BeEquivalentTo(0))
+		Expect(results[0].SemverRange).To(Equal("<1.2.0"))
+		Expect(results[0].Outcome).To(Equal(v1beta1.UpgradePatchSkipped))
+		Expect(results[0].Message).To(ContainSubstring("not in the patch semver range"))
+
+		Expect(results[1].Index).To(BeEquivalentTo(1))
+		Expect(results[1].Outcome).To(Equal(v1beta1.UpgradePatchApplied))
+		Expect(results[1].Message).To(BeEmpty())
+
+		Expect(results[2].Index).To(BeEquivalentTo(2))
+		Expect(results[2].Outcome).To(Equal(v1beta1.UpgradePatchTestFailed))
+		Expect(results[2].Message).ToNot(BeEmpty())
+	})
+
+	DescribeTable("should skip the patch if a precondition is not met", func(modify func(*v1beta1.HyperConverged), message string) {
+		hc := components.GetOperatorCR()
+		modify(hc)
+
+		newHc, results, err := patches.ApplyUpgradePatch(GinkgoLogr, hc, ver, facts)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(newHc.Spec.FeatureGates.DownwardMetrics).To(HaveValue(BeFalse()))
+		Expect(results).To(HaveLen(3))
+		Expect(results[1].Outcome).To(Equal(v1beta1.UpgradePatchSkipped))
+		Expect(results[1].Message).To(Equal(message))
+	},
+		Entry("not OpenShift", func(_ *v1beta1.HyperConverged) {
+			facts.IsOpenshift = false
+		}, "the cluster is not an OpenShift cluster"),
+		Entry("feature gate value", func(hc *v1beta1.HyperConverged) {
+			hc.Spec.FeatureGates.DownwardMetrics = ptr.To(false)
+			patches.HCOCRPatchList[1].Preconditions.FeatureGates["downwardMetrics"] = true
+		}, "the downwardMetrics feature gate is not true"),
+		Entry("missing CRD", func(_ *v1beta1.HyperConverged) {
+			facts.CRDExists = func(string) (bool, error) { return false, nil }
+		}, "the something.example.com CRD does not exist"),
+		Entry("architecture", func(_ *v1beta1.HyperConverged) {
+			facts.WorkloadsArchitectures = []string{"amd64"}
+		}, "no workloads node with any of the [arm64] architectures"),
+	)
+
+	It("should return an error if failed to check if a CRD exists", func() {
+		facts.CRDExists = func(string) (bool, error) { return false, errors.New("fake error") }
+
+		_, _, err := patches.ApplyUpgradePatch(GinkgoLogr, components.GetOperatorCR(), ver, facts)
+		Expect(err).To(MatchError("fake error"))
+	})
+})
+
 func copyTestFile(filename string) error {
 	return commontestutils.CopyFile(origFile, path.Join(getTestFilesLocation(), filename))
 }
@@ -28,11 +28,15 @@ const (
 	DeschedulerCRDName                 = "kubedeschedulers.operator.openshift.io"
 	NetworkAttachmentDefinitionCRDName = "network-attachment-definitions.k8s.cni.cncf.io"
 	HcoMutatingWebhookHyperConverged   = "mutate-hyperconverged-hco.kubevirt.io"
+	HcoConversionWebhookHyperConverged = "convert-hyperconverged-hco.kubevirt.io"
+	HcoOperandsValidatingWebhook       = "validate-operands-hco.kubevirt.io"
+	HcoMutatingWebhookManagedNS        = "mutate-managed-ns-hco.kubevirt.io"
 	AppLabel                           = "app"
 	UndefinedNamespace                 = ""
 	OpenshiftNamespace                 = "openshift"
 	APIVersionAlpha                    = "v1alpha1"
 	APIVersionBeta                     = "v1beta1"
+	APIVersionV1                       = "v1"
 	CurrentAPIVersion                  = APIVersionBeta
 	APIVersionGroup                    = "hco.kubevirt.io"
 	APIVersion                         = APIVersionGroup + "/" + CurrentAPIVersion
@@ -46,6 +50,10 @@ const (
 	AppLabelComponent = AppLabelPrefix + "/component"
 	// Operator name for managed-by label
 	OperatorName = "hco-operator"
+	// HCOServiceAccountName is the name of the service account of the HCO operator and webhook
+	HCOServiceAccountName = "hyperconverged-cluster-operator"
+	// HCOFieldManager is the field manager HCO uses when it server-side applies the resources it manages
+	HCOFieldManager = "hyperconverged-cluster-operator"
 	// Value for "part-of" label
 	HyperConvergedCluster    = "hyperconverged-cluster"
 	OpenshiftNodeSelectorAnn = "openshift.io/node-selector"
@@ -66,6 +74,9 @@ const (
 	HCOWebhookPath               = "/validate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCOMutatingWebhookPath       = "/mutate-hco-kubevirt-io-v1beta1-hyperconverged"
 	HCONSWebhookPath             = "/mutate-ns-hco-kubevirt-io"
+	HCOConvertWebhookPath        = "/convert-hco-kubevirt-io-hyperconverged"
+	HCOWhatIfPath                = "/whatif-hco-kubevirt-io-hyperconverged"
+	HCOOperandsWebhookPath       = "/validate-hco-kubevirt-io-operands"
 	WebhookPort                  = 4343
 	WebhookPortName              = "webhook"
 
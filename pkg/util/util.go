@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -222,9 +223,16 @@ func EnsureDeleted(ctx context.Context, c client.Client, obj client.Object, hcoN
 	return ComponentResourceRemoval(ctx, c, obj, hcoName, logger, dryRun, wait, protectNonHCOObjects)
 }
 
-var hcoKvIoVersion string
+var (
+	hcoKvIoVersion     string
+	hcoKvIoVersionLock sync.Mutex
+)
 
 func GetHcoKvIoVersion() string {
+	// the operands may be reconciled concurrently
+	hcoKvIoVersionLock.Lock()
+	defer hcoKvIoVersionLock.Unlock()
+
 	if hcoKvIoVersion == "" {
 		hcoKvIoVersion = os.Getenv(HcoKvIoVersionName)
 	}
@@ -12,9 +12,12 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
 	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 )
 
 var (
@@ -53,7 +56,7 @@ const (
 	dictAnnotationPathTemplate = annotationPathTemplate + "/cdi.kubevirt.io~1storage.bind.immediate.requested"
 )
 
-func (hcm *HyperConvergedMutator) mutateHyperConverged(_ context.Context, req admission.Request) admission.Response {
+func (hcm *HyperConvergedMutator) mutateHyperConverged(ctx context.Context, req admission.Request) admission.Response {
 	hc := &hcov1beta1.HyperConverged{}
 	err := hcm.decoder.Decode(req, hc)
 	if err != nil {
@@ -80,6 +83,13 @@ func (hcm *HyperConvergedMutator) mutateHyperConverged(_ context.Context, req ad
 
 	patches = mutateEvictionStrategy(hc, patches)
 
+	// only propose the node placement of a new cluster; on update, a missing node placement may be intentional, and
+	// modifying it would move the existing workloads
+	var warnings []string
+	if req.Operation == admissionv1.Create {
+		patches, warnings = hcm.mutateNodePlacement(ctx, hc, patches)
+	}
+
 	if hc.Spec.MediatedDevicesConfiguration != nil {
 		if len(hc.Spec.MediatedDevicesConfiguration.MediatedDevicesTypes) > 0 && len(hc.Spec.MediatedDevicesConfiguration.MediatedDeviceTypes) == 0 { //nolint SA1019
 			patches = append(patches, jsonpatch.JsonPatchOperation{
@@ -100,10 +110,10 @@ func (hcm *HyperConvergedMutator) mutateHyperConverged(_ context.Context, req ad
 	}
 
 	if len(patches) > 0 {
-		return admission.Patched("mutated", patches...)
+		return admission.Patched("mutated", patches...).WithWarnings(warnings...)
 	}
 
-	return admission.Allowed("")
+	return admission.Allowed("").WithWarnings(warnings...)
 }
 
 func mutateEvictionStrategy(hc *hcov1beta1.HyperConverged, patches []jsonpatch.JsonPatchOperation) []jsonpatch.JsonPatchOperation {
@@ -124,3 +134,46 @@ func mutateEvictionStrategy(hc *hcov1beta1.HyperConverged, patches []jsonpatch.J
 
 	return patches
 }
+
+// mutateNodePlacement proposes the missing infra and workloads node placement of a new HyperConverged CR, from the
+// roles of the cluster nodes, if the node placement defaults annotation is set. The rationale of the proposals is
+// returned as warnings.
+func (hcm *HyperConvergedMutator) mutateNodePlacement(ctx context.Context, hc *hcov1beta1.HyperConverged, patches []jsonpatch.JsonPatchOperation) ([]jsonpatch.JsonPatchOperation, []string) {
+	if hc.Annotations[common.NodePlacementDefaultsAnnotationName] != "true" ||
+		(hc.Spec.Infra.NodePlacement != nil && hc.Spec.Workloads.NodePlacement != nil) {
+		return patches, nil
+	}
+
+	infra, workloads, err := nodeinfo.ProposeNodePlacement(ctx, hcm.cli)
+	if err != nil {
+		hcMutatorLogger.Error(err, "failed to propose the node placement")
+		return patches, []string{fmt.Sprintf("can't propose the node placement: %v", err)}
+	}
+
+	var warnings []string
+	for _, field := range []struct {
+		name     string
+		current  *sdkapi.NodePlacement
+		proposal nodeinfo.NodePlacementProposal
+	}{
+		{name: "infra", current: hc.Spec.Infra.NodePlacement, proposal: infra},
+		{name: "workloads", current: hc.Spec.Workloads.NodePlacement, proposal: workloads},
+	} {
+		if field.current != nil {
+			continue
+		}
+
+		warnings = append(warnings, fmt.Sprintf("spec.%s.nodePlacement: %s", field.name, field.proposal.Reason))
+
+		if field.proposal.NodePlacement != nil {
+			// replace the whole field, as it may be missing in the request
+			patches = append(patches, jsonpatch.JsonPatchOperation{
+				Operation: "add",
+				Path:      "/spec/" + field.name,
+				Value:     hcov1beta1.HyperConvergedConfig{NodePlacement: field.proposal.NodePlacement},
+			})
+		}
+	}
+
+	return patches, warnings
+}
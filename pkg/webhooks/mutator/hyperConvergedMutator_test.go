@@ -8,6 +8,7 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"gomodules.xyz/jsonpatch/v2"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -17,8 +18,10 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	sdkapi "kubevirt.io/controller-lifecycle-operator-sdk/api"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	goldenimages "github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/golden-images"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
@@ -323,6 +326,98 @@ var _ = Describe("test HyperConverged mutator", func() {
 			)
 		})
 
+		Context("Check node placement defaults", func() {
+			newNode := func(name string, roles ...string) client.Object {
+				labels := map[string]string{}
+				for _, role := range roles {
+					labels[role] = ""
+				}
+				return &corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+			}
+
+			BeforeEach(func() {
+				cli = commontestutils.InitClient([]client.Object{
+					newNode("cp", "node-role.kubernetes.io/control-plane"),
+					newNode("infra", "node-role.kubernetes.io/infra"),
+					newNode("worker1", "node-role.kubernetes.io/worker"),
+					newNode("worker2", "node-role.kubernetes.io/worker"),
+				})
+				mutator = initHCMutator(s, cli)
+			})
+
+			It("should not propose the node placement without the annotation", func() {
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}
+
+				res := mutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(BeEmpty())
+				Expect(res.Warnings).To(BeEmpty())
+			})
+
+			It("should propose the missing node placement, with the rationale as warnings", func() {
+				cr.Annotations = map[string]string{common.NodePlacementDefaultsAnnotationName: "true"}
+
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}
+
+				res := mutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(Equal([]jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/spec/infra",
+						Value: v1beta1.HyperConvergedConfig{NodePlacement: &sdkapi.NodePlacement{
+							NodeSelector: map[string]string{"node-role.kubernetes.io/infra": ""},
+						}},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/workloads",
+						Value: v1beta1.HyperConvergedConfig{NodePlacement: &sdkapi.NodePlacement{
+							NodeSelector: map[string]string{"node-role.kubernetes.io/worker": ""},
+						}},
+					},
+				}))
+				Expect(res.Warnings).To(Equal([]string{
+					"spec.infra.nodePlacement: 1 node has the infra role; the infra components are placed on it",
+					"spec.workloads.nodePlacement: 2 of the 4 nodes have the worker role; the workloads components are placed on them",
+				}))
+			})
+
+			It("should not modify an existing node placement", func() {
+				cr.Annotations = map[string]string{common.NodePlacementDefaultsAnnotationName: "true"}
+				cr.Spec.Workloads.NodePlacement = &sdkapi.NodePlacement{NodeSelector: map[string]string{"some": "label"}}
+
+				req := admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}
+
+				res := mutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(HaveLen(1))
+				Expect(res.Patches[0].Path).To(Equal("/spec/infra"))
+				Expect(res.Warnings).To(ConsistOf(HavePrefix("spec.infra.nodePlacement: ")))
+
+				By("not proposing anything if both are set")
+				cr.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{}
+				req = admission.Request{AdmissionRequest: newCreateRequest(cr, hcoV1beta1Codec)}
+
+				res = mutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(BeEmpty())
+				Expect(res.Warnings).To(BeEmpty())
+			})
+
+			It("should not propose the node placement on update", func() {
+				cr.Annotations = map[string]string{common.NodePlacementDefaultsAnnotationName: "true"}
+
+				origCR := cr.DeepCopy()
+				req := admission.Request{AdmissionRequest: newUpdateRequest(origCR, cr, hcoV1beta1Codec)}
+
+				res := mutator.Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Patches).To(BeEmpty())
+				Expect(res.Warnings).To(BeEmpty())
+			})
+		})
+
 		DescribeTable("Check mediatedDevicesTypes -> mediatedDeviceTypes transition", func(initialMDConfiguration *v1beta1.MediatedDevicesConfiguration, patches []jsonpatch.JsonPatchOperation) {
 			cr.Spec.MediatedDevicesConfiguration = initialMDConfiguration
 
@@ -0,0 +1,96 @@
+package mutator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/alerts"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
+)
+
+const passtNADNamespace = "default"
+
+// namespaceUse is the reason HCO uses a namespace other than its own namespace. If deny is true, the namespace can't be
+// deleted while the HyperConverged CR uses it; otherwise, its deletion is accepted with a warning.
+type namespaceUse struct {
+	deny   bool
+	reason string
+}
+
+// getManagedNamespaces returns the namespaces, other than the HCO namespace, that HCO deploys resources into, or
+// depends on, according to the HyperConverged CR
+func (nm *NsMutator) getManagedNamespaces(hc *v1beta1.HyperConverged) map[string][]namespaceUse {
+	namespaces := map[string][]namespaceUse{}
+	addUse := func(ns string, deny bool, reason string) {
+		if ns != "" && ns != nm.namespace {
+			namespaces[ns] = append(namespaces[ns], namespaceUse{deny: deny, reason: reason})
+		}
+	}
+
+	if ns := hc.Spec.CommonTemplatesNamespace; ns != nil {
+		addUse(*ns, true, "the common templates are deployed into it, according to spec.commonTemplatesNamespace")
+	}
+
+	dictNames := map[string][]string{}
+	var dictNamespaces []string
+	for _, dict := range hc.Status.DataImportCronTemplates {
+		if dict.Namespace == "" {
+			continue
+		}
+		if _, exists := dictNames[dict.Namespace]; !exists {
+			dictNamespaces = append(dictNamespaces, dict.Namespace)
+		}
+		dictNames[dict.Namespace] = append(dictNames[dict.Namespace], dict.Name)
+	}
+	for _, ns := range dictNamespaces {
+		addUse(ns, true, fmt.Sprintf("the golden images of the %s DataImportCronTemplates are imported into it", strings.Join(dictNames[ns], ", ")))
+	}
+
+	if hc.Annotations[passt.DeployPasstNetworkBindingAnnotation] == "true" {
+		addUse(passtNADNamespace, false, "the NetworkAttachmentDefinition of the passt network binding is deployed into it")
+	}
+
+	addUse(alerts.GetMonitoringNamespace(nm.ci), false, "the cluster monitoring in it scrapes the HCO metrics, and fires the HCO alerts")
+
+	return namespaces
+}
+
+// handleManagedNsDelete denies, or warns about, the deletion of the namespaces that HCO uses, other than its own
+// namespace, while the HyperConverged CR exists
+func (nm *NsMutator) handleManagedNsDelete(ctx context.Context, ns *corev1.Namespace) admission.Response {
+	hc, err := getHcoObject(ctx, nm.cli, nm.namespace)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Allowed(admittingDeletionMessage)
+		}
+		// this webhook must not block the deletion of unrelated namespaces
+		return admission.Allowed("").WithWarnings(fmt.Sprintf("failed to check if HCO uses the %s namespace: %v", ns.Name, err))
+	}
+
+	uses, managed := nm.getManagedNamespaces(hc)[ns.Name]
+	if !managed {
+		return admission.Allowed("the namespace is not used by HCO")
+	}
+
+	deny := false
+	reasons := make([]string, 0, len(uses))
+	for _, use := range uses {
+		deny = deny || use.deny
+		reasons = append(reasons, use.reason)
+	}
+
+	logger.Info("deletion of a namespace used by HCO", "name", ns.Name, "denied", deny)
+
+	if deny {
+		return admission.Denied(fmt.Sprintf("the %s namespace is used by the HyperConverged CR: %s; modify the HyperConverged CR to stop using this namespace, or remove the HyperConverged CR, before deleting it",
+			ns.Name, strings.Join(reasons, "; ")))
+	}
+
+	return admission.Allowed("").WithWarnings(fmt.Sprintf("the %s namespace is used by HCO: %s", ns.Name, strings.Join(reasons, "; ")))
+}
@@ -10,6 +10,8 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
 const (
@@ -29,13 +31,15 @@ type NsMutator struct {
 	decoder   admission.Decoder
 	cli       client.Client
 	namespace string
+	ci        hcoutil.ClusterInfo
 }
 
-func NewNsMutator(cli client.Client, decoder admission.Decoder, namespace string) *NsMutator {
+func NewNsMutator(cli client.Client, decoder admission.Decoder, namespace string, ci hcoutil.ClusterInfo) *NsMutator {
 	return &NsMutator{
 		cli:       cli,
 		namespace: namespace,
 		decoder:   decoder,
+		ci:        ci,
 	}
 }
 
@@ -62,6 +66,10 @@ func (nm *NsMutator) handleNsDelete(ctx context.Context, req admission.Request)
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
+	if ns.Name != nm.namespace {
+		return nm.handleManagedNsDelete(ctx, ns)
+	}
+
 	admitted, err := nm.handleMutatingNsDelete(ctx, ns)
 	if err != nil {
 		return admission.Errored(http.StatusInternalServerError, err)
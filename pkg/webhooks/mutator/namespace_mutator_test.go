@@ -10,10 +10,12 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers/passt"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -138,6 +140,80 @@ var _ = Describe("webhooks mutator", func() {
 			Expect(res.Allowed).To(BeTrue())
 		})
 
+		Context("namespaces used by HCO", func() {
+			var hc *v1beta1.HyperConverged
+
+			BeforeEach(func() {
+				hc = cr.DeepCopy()
+				hc.Spec.CommonTemplatesNamespace = ptr.To("templates")
+				hc.Annotations = map[string]string{passt.DeployPasstNetworkBindingAnnotation: "true"}
+				hc.Status.DataImportCronTemplates = []v1beta1.DataImportCronTemplateStatus{
+					{DataImportCronTemplate: v1beta1.DataImportCronTemplate{ObjectMeta: metav1.ObjectMeta{Name: "fedora", Namespace: "images"}}},
+					{DataImportCronTemplate: v1beta1.DataImportCronTemplate{ObjectMeta: metav1.ObjectMeta{Name: "centos", Namespace: "images"}}},
+					{DataImportCronTemplate: v1beta1.DataImportCronTemplate{ObjectMeta: metav1.ObjectMeta{Name: "custom", Namespace: "templates"}}},
+				}
+			})
+
+			deleteNs := func(name string, objs ...client.Object) admission.Response {
+				nsMutator := initMutator(s, commontestutils.InitClient(objs))
+				toDelete := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, toDelete, corev1Codec)}
+
+				return nsMutator.Handle(context.TODO(), req)
+			}
+
+			It("should deny the deletion of the common templates namespace", func() {
+				res := deleteNs("templates", hc)
+				Expect(res.Allowed).To(BeFalse())
+				Expect(res.Result.Message).To(Equal("the templates namespace is used by the HyperConverged CR: " +
+					"the common templates are deployed into it, according to spec.commonTemplatesNamespace; " +
+					"the golden images of the custom DataImportCronTemplates are imported into it; " +
+					"modify the HyperConverged CR to stop using this namespace, or remove the HyperConverged CR, before deleting it"))
+			})
+
+			It("should deny the deletion of the golden images namespace", func() {
+				res := deleteNs("images", hc)
+				Expect(res.Allowed).To(BeFalse())
+				Expect(res.Result.Message).To(ContainSubstring("the golden images of the fedora, centos DataImportCronTemplates are imported into it"))
+			})
+
+			DescribeTable("should warn about the deletion of the shared namespaces", func(name, expectedWarning string) {
+				res := deleteNs(name, hc)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Warnings).To(ConsistOf(expectedWarning))
+			},
+				Entry("monitoring namespace", "openshift-monitoring",
+					"the openshift-monitoring namespace is used by HCO: the cluster monitoring in it scrapes the HCO metrics, and fires the HCO alerts"),
+				Entry("passt NetworkAttachmentDefinition namespace", "default",
+					"the default namespace is used by HCO: the NetworkAttachmentDefinition of the passt network binding is deployed into it"),
+			)
+
+			It("should allow the deletion of the namespaces, if the HyperConverged CR does not exist", func() {
+				for _, name := range []string{"templates", "images", "openshift-monitoring"} {
+					res := deleteNs(name)
+					Expect(res.Allowed).To(BeTrue())
+					Expect(res.Warnings).To(BeEmpty())
+				}
+			})
+
+			It("should allow the deletion of a namespace with a warning, if failed to read the HyperConverged CR", func() {
+				cli := commontestutils.InitClient([]client.Object{hc})
+				cli.InitiateGetErrors(func(key client.ObjectKey) error {
+					if key.Name == util.HyperConvergedName {
+						return ErrFakeHcoError
+					}
+					return nil
+				})
+
+				toDelete := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "templates"}}
+				req := admission.Request{AdmissionRequest: newRequest(admissionv1.Delete, toDelete, corev1Codec)}
+
+				res := initMutator(s, cli).Handle(context.TODO(), req)
+				Expect(res.Allowed).To(BeTrue())
+				Expect(res.Warnings).To(ConsistOf(ContainSubstring("fake HyperConverged error")))
+			})
+		})
+
 		It("should allow other operations", func() {
 			cli := commontestutils.InitClient([]client.Object{cr})
 			nsMutator := initMutator(s, cli)
@@ -152,7 +228,7 @@ var _ = Describe("webhooks mutator", func() {
 
 func initMutator(s *runtime.Scheme, testClient client.Client) *NsMutator {
 	decoder := admission.NewDecoder(s)
-	nsMutator := NewNsMutator(testClient, decoder, HcoValidNamespace)
+	nsMutator := NewNsMutator(testClient, decoder, HcoValidNamespace, commontestutils.ClusterInfoMock{})
 
 	return nsMutator
 }
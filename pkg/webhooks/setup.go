@@ -2,6 +2,7 @@ package webhooks
 
 import (
 	"context"
+	"net/http"
 	"os"
 
 	openshiftconfigv1 "github.com/openshift/api/config/v1"
@@ -16,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
@@ -34,8 +36,9 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	decoder := admission.NewDecoder(mgr.GetScheme())
 
 	whHandler := validator.NewWebhookHandler(logger, mgr.GetClient(), decoder, operatorNsEnv, isOpenshift, hcoTLSSecurityProfile)
-	nsMutator := mutator.NewNsMutator(mgr.GetClient(), decoder, operatorNsEnv)
+	nsMutator := mutator.NewNsMutator(mgr.GetClient(), decoder, operatorNsEnv, hcoutil.GetClusterInfo())
 	hyperConvergedMutator := mutator.NewHyperConvergedMutator(mgr.GetClient(), decoder)
+	operandsValidator := validator.NewOperandsValidator(logger, mgr.GetClient(), decoder, operatorNsEnv)
 
 	if err := allowWatchAllNamespaces(ctx, mgr); err != nil {
 		return err
@@ -46,6 +49,11 @@ func SetupWebhookWithManager(ctx context.Context, mgr ctrl.Manager, isOpenshift
 	srv.Register(hcoutil.HCONSWebhookPath, &webhook.Admission{Handler: nsMutator})
 	srv.Register(hcoutil.HCOMutatingWebhookPath, &webhook.Admission{Handler: hyperConvergedMutator})
 	srv.Register(hcoutil.HCOWebhookPath, &webhook.Admission{Handler: whHandler})
+	srv.Register(hcoutil.HCOOperandsWebhookPath, &webhook.Admission{Handler: operandsValidator})
+	// renders the operand CRs from a HyperConverged CR, without modifying the cluster
+	srv.Register(hcoutil.HCOWhatIfPath, http.HandlerFunc(whHandler.ServeWhatIf))
+	// converts the HyperConverged CR between its API versions; see the Hub and Convertible implementations in api/
+	srv.Register(hcoutil.HCOConvertWebhookPath, conversion.NewWebhookHandler(mgr.GetScheme()))
 
 	return nil
 }
@@ -91,5 +99,39 @@ func allowWatchAllNamespaces(ctx context.Context, mgr ctrl.Manager) error {
 			}
 		}
 	}
+
+	return allowManagedNamespacesDeletionCheck(ctx, mgr)
+}
+
+// The same as above, for the webhook that checks the deletion of the namespaces HCO uses, other than its own namespace.
+// For namespaces, the namespaceSelector is matched against the deleted namespace itself, so the OLM scope limits this
+// webhook to the HCO namespace, that it ignores.
+func allowManagedNamespacesDeletionCheck(ctx context.Context, mgr ctrl.Manager) error {
+	mwcList := &admissionregistrationv1.MutatingWebhookConfigurationList{}
+	err := mgr.GetAPIReader().List(ctx, mwcList, client.MatchingLabels{"olm.webhook-description-generate-name": hcoutil.HcoMutatingWebhookManagedNS})
+	if err != nil {
+		logger.Error(err, "A mutating webhook for the HCO managed namespaces was not found")
+		return err
+	}
+
+	for _, mwc := range mwcList.Items {
+		update := false
+
+		for i, wh := range mwc.Webhooks {
+			if wh.Name == hcoutil.HcoMutatingWebhookManagedNS {
+				mwc.Webhooks[i].NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{}}
+				update = true
+			}
+		}
+
+		if update {
+			logger.Info("Removing namespace scope from webhook", "webhook", mwc.Name)
+			err = mgr.GetClient().Update(ctx, &mwc)
+			if err != nil {
+				logger.Error(err, "Failed updating webhook", "webhook", mwc.Name)
+				return err
+			}
+		}
+	}
 	return nil
 }
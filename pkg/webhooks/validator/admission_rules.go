@@ -0,0 +1,264 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/ext"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+)
+
+const (
+	// AdmissionRulesConfigMapName is the name of the ConfigMap, in the HCO namespace, with the admission rules
+	AdmissionRulesConfigMapName = "hco-admission-rules"
+
+	admissionRuleActionDeny = "Deny"
+	admissionRuleActionWarn = "Warn"
+
+	// the maximum runtime cost of a single rule evaluation
+	admissionRuleCostLimit = 1000000
+)
+
+// admissionRule is a CEL expression, that must be evaluated to true for the HyperConverged CR to be admitted
+type admissionRule struct {
+	Name       string `json:"name"`
+	Expression string `json:"expression"`
+	Message    string `json:"message,omitempty"`
+	// Action is either Deny (the default) or Warn
+	Action string `json:"action,omitempty"`
+	// Operations are the operations the rule applies to; CREATE, UPDATE or both (the default)
+	Operations []admissionv1.Operation `json:"operations,omitempty"`
+}
+
+type compiledAdmissionRule struct {
+	admissionRule
+	program cel.Program
+	// err is the reason the rule is invalid
+	err error
+}
+
+// admissionRulesCache keeps the compiled rules, so they are only compiled when the ConfigMap is modified
+type admissionRulesCache struct {
+	lock            sync.Mutex
+	resourceVersion string
+	rules           []compiledAdmissionRule
+}
+
+var admissionRulesEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("object", cel.DynType),
+		cel.Variable("oldObject", cel.DynType),
+		cel.Variable("request", cel.DynType),
+		ext.Strings(),
+	)
+})
+
+// evaluateAdmissionRules evaluates the rules in the admission rules ConfigMap, against the requested HyperConverged
+// CR. Returns an error with the messages of the failed Deny rules, if any, and the messages of the failed Warn rules,
+// as warnings. An invalid rule, or a rule that can't be evaluated, is considered as failed, with its action. If the
+// action of the rule is unknown, e.g. if the rules can't be read, the rule only produces a warning.
+//
+// The rules are not evaluated for the requests of HCO itself, so they never block the reconciliation or the upgrade.
+func (wh *WebhookHandler) evaluateAdmissionRules(ctx context.Context, operation admissionv1.Operation, requested, exists *v1beta1.HyperConverged) ([]string, error) {
+	if req, err := admission.RequestFromContext(ctx); err == nil && isHCOServiceAccount(req.UserInfo.Username, wh.namespace) {
+		return nil, nil
+	}
+
+	rules, err := wh.getAdmissionRules(ctx)
+	if err != nil || len(rules) == 0 {
+		return nil, err
+	}
+
+	vars, err := getAdmissionRuleVars(ctx, operation, requested, exists)
+	if err != nil {
+		return nil, err
+	}
+
+	var denials, warnings []string
+	for _, rule := range rules {
+		// an invalid rule may have invalid operations, so it is never skipped
+		if rule.err == nil && len(rule.Operations) > 0 && !slices.Contains(rule.Operations, operation) {
+			continue
+		}
+
+		msg, err := rule.evaluate(ctx, vars)
+		if err != nil {
+			wh.logger.Error(err, "failed to evaluate an admission rule", "rule", rule.Name)
+			msg = err.Error()
+		}
+
+		if msg == "" {
+			continue
+		}
+
+		if rule.Action == admissionRuleActionWarn {
+			warnings = append(warnings, msg)
+		} else {
+			denials = append(denials, msg)
+		}
+	}
+
+	if len(denials) > 0 {
+		return nil, fmt.Errorf("denied by the admission rules: %s", strings.Join(denials, "; "))
+	}
+
+	return warnings, nil
+}
+
+// evaluate returns the failure message of the rule, or an empty string if the rule passed. An error is returned if
+// the rule is invalid, or if it can't be evaluated.
+func (rule compiledAdmissionRule) evaluate(ctx context.Context, vars map[string]any) (string, error) {
+	if rule.err != nil {
+		return "", fmt.Errorf("rule %q is invalid: %w", rule.Name, rule.err)
+	}
+
+	out, _, err := rule.program.ContextEval(ctx, vars)
+	if err != nil {
+		return "", fmt.Errorf("rule %q can't be evaluated: %w", rule.Name, err)
+	}
+
+	passed, ok := out.Value().(bool)
+	if !ok {
+		return "", fmt.Errorf("rule %q can't be evaluated: expected a bool result, but got %s", rule.Name, out.Type().TypeName())
+	}
+
+	if passed {
+		return "", nil
+	}
+
+	if rule.Message != "" {
+		return fmt.Sprintf("rule %q: %s", rule.Name, rule.Message), nil
+	}
+	return fmt.Sprintf("rule %q: failed expression: %s", rule.Name, rule.Expression), nil
+}
+
+func getAdmissionRuleVars(ctx context.Context, operation admissionv1.Operation, requested, exists *v1beta1.HyperConverged) (map[string]any, error) {
+	object, err := runtime.DefaultUnstructuredConverter.ToUnstructured(requested)
+	if err != nil {
+		return nil, err
+	}
+
+	var oldObject any = types.NullValue
+	if exists != nil {
+		if oldObject, err = runtime.DefaultUnstructuredConverter.ToUnstructured(exists); err != nil {
+			return nil, err
+		}
+	}
+
+	request := map[string]any{
+		"operation": string(operation),
+		"userInfo": map[string]any{
+			"username": "",
+			"groups":   []string{},
+		},
+	}
+	if req, err := admission.RequestFromContext(ctx); err == nil {
+		request["userInfo"] = map[string]any{
+			"username": req.UserInfo.Username,
+			"groups":   slices.Clone(req.UserInfo.Groups),
+		}
+	}
+
+	return map[string]any{
+		"object":    object,
+		"oldObject": oldObject,
+		"request":   request,
+	}, nil
+}
+
+// getAdmissionRules reads and compiles the rules from the admission rules ConfigMap
+func (wh *WebhookHandler) getAdmissionRules(ctx context.Context) ([]compiledAdmissionRule, error) {
+	cm := &corev1.ConfigMap{}
+	err := wh.cli.Get(ctx, client.ObjectKey{Namespace: wh.namespace, Name: AdmissionRulesConfigMapName}, cm)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		wh.logger.Error(err, "failed to read the admission rules ConfigMap")
+		return nil, err
+	}
+
+	wh.rulesCache.lock.Lock()
+	defer wh.rulesCache.lock.Unlock()
+
+	if cm.ResourceVersion == "" || cm.ResourceVersion != wh.rulesCache.resourceVersion {
+		wh.rulesCache.rules = compileAdmissionRules(cm)
+		wh.rulesCache.resourceVersion = cm.ResourceVersion
+	}
+
+	return wh.rulesCache.rules, nil
+}
+
+// compileAdmissionRules reads the rules from the ConfigMap. Each value in the ConfigMap is a YAML or JSON list of
+// rules.
+func compileAdmissionRules(cm *corev1.ConfigMap) []compiledAdmissionRule {
+	var rules []compiledAdmissionRule
+	for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+		var keyRules []admissionRule
+		if err := yaml.NewYAMLOrJSONDecoder(strings.NewReader(cm.Data[key]), 4096).Decode(&keyRules); err != nil {
+			// the actions of the rules are unknown
+			rules = append(rules, compiledAdmissionRule{
+				admissionRule: admissionRule{Name: key, Action: admissionRuleActionWarn},
+				err:           fmt.Errorf("can't read the rules: %w", err),
+			})
+			continue
+		}
+
+		for _, rule := range keyRules {
+			program, err := compileAdmissionRule(rule)
+			if err != nil && rule.Action != "" && rule.Action != admissionRuleActionDeny {
+				rule.Action = admissionRuleActionWarn
+			}
+			rules = append(rules, compiledAdmissionRule{admissionRule: rule, program: program, err: err})
+		}
+	}
+
+	return rules
+}
+
+func compileAdmissionRule(rule admissionRule) (cel.Program, error) {
+	if rule.Name == "" {
+		return nil, errors.New("missing name")
+	}
+
+	if rule.Action != "" && rule.Action != admissionRuleActionDeny && rule.Action != admissionRuleActionWarn {
+		return nil, fmt.Errorf("unknown action %q; must be either %s or %s", rule.Action, admissionRuleActionDeny, admissionRuleActionWarn)
+	}
+
+	for _, op := range rule.Operations {
+		if op != admissionv1.Create && op != admissionv1.Update {
+			return nil, fmt.Errorf("unsupported operation %q; must be either %s or %s", op, admissionv1.Create, admissionv1.Update)
+		}
+	}
+
+	env, err := admissionRulesEnv()
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(rule.Expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+
+	if outType := ast.OutputType(); !outType.IsExactType(cel.BoolType) && !outType.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("the expression must return a bool, but it returns %s", outType)
+	}
+
+	return env.Program(ast, cel.CostLimit(admissionRuleCostLimit))
+}
@@ -0,0 +1,226 @@
+package validator
+
+import (
+	"context"
+	"errors"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	openshiftconfigv1 "github.com/openshift/api/config/v1"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+var _ = Describe("admission rules", func() {
+	const (
+		noPostCopyRule = `
+- name: no-post-copy
+  expression: "!has(object.spec.liveMigrationConfig.allowPostCopy) || !object.spec.liveMigrationConfig.allowPostCopy"
+  message: post-copy migrations are not allowed
+`
+		tlsProfileRule = `
+- name: tls-profile
+  expression: "has(object.spec.tlsSecurityProfile) && (has(object.spec.tlsSecurityProfile.intermediate) || has(object.spec.tlsSecurityProfile.modern))"
+  message: the TLS profile must be Intermediate or Modern
+  action: Warn
+`
+		hostDevicesRule = `
+- name: host-devices-approval
+  expression: "!has(object.spec.permittedHostDevices) || 'security-approvers' in request.userInfo.groups"
+  message: permittedHostDevices must be approved
+  operations: [UPDATE]
+`
+		noEvictionChangeRule = `
+- name: eviction-strategy
+  expression: "oldObject == null || object.spec.evictionStrategy != 'None'"
+`
+	)
+
+	var (
+		hco *v1beta1.HyperConverged
+		ctx context.Context
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		ctx = context.Background()
+	})
+
+	newRulesConfigMap := func(data map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      AdmissionRulesConfigMapName,
+				Namespace: HcoValidNamespace,
+			},
+			Data: data,
+		}
+	}
+
+	newHandler := func(cm *corev1.ConfigMap) *WebhookHandler {
+		cli := getFakeClient(hco)
+		if cm != nil {
+			Expect(cli.Create(ctx, cm)).To(Succeed())
+		}
+		return NewWebhookHandler(logger, cli, admission.NewDecoder(commontestutils.GetScheme()), HcoValidNamespace, true, nil)
+	}
+
+	getWarnings := func(err error) []string {
+		vw := &ValidationWarning{}
+		ExpectWithOffset(1, errors.As(err, &vw)).To(BeTrue(), "expected a warning, but got %v", err)
+		return vw.Warnings()
+	}
+
+	It("should accept any request, if there is no rules ConfigMap", func() {
+		wh := newHandler(nil)
+
+		newHco := hco.DeepCopy()
+		newHco.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(true)
+		Expect(wh.ValidateUpdate(ctx, false, newHco, hco)).To(Succeed())
+	})
+
+	It("should deny a request that fails a Deny rule", func() {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": noPostCopyRule}))
+
+		cr := hco.DeepCopy()
+		cr.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(true)
+		Expect(wh.ValidateCreate(ctx, false, cr)).To(MatchError(
+			`denied by the admission rules: rule "no-post-copy": post-copy migrations are not allowed`))
+
+		Expect(wh.ValidateUpdate(ctx, false, cr, hco)).To(MatchError(ContainSubstring(`rule "no-post-copy"`)))
+
+		cr.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(false)
+		Expect(wh.ValidateUpdate(ctx, false, cr, hco)).To(Succeed())
+	})
+
+	It("should return a warning for a failed Warn rule", func() {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": tlsProfileRule}))
+
+		cr := hco.DeepCopy()
+		cr.Spec.TLSSecurityProfile = nil
+		Expect(getWarnings(wh.ValidateCreate(ctx, false, cr))).To(ConsistOf(
+			`rule "tls-profile": the TLS profile must be Intermediate or Modern`))
+
+		cr.Spec.TLSSecurityProfile = &openshiftconfigv1.TLSSecurityProfile{
+			Type:         openshiftconfigv1.TLSProfileIntermediateType,
+			Intermediate: &openshiftconfigv1.IntermediateTLSProfile{},
+		}
+		Expect(wh.ValidateCreate(ctx, false, cr)).To(Succeed())
+	})
+
+	It("should only evaluate the rules of the requested operation, and expose the requesting user", func() {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": hostDevicesRule}))
+
+		cr := hco.DeepCopy()
+		cr.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
+			PciHostDevices: []v1beta1.PciHostDevice{{PCIDeviceSelector: "10DE:1EB8", ResourceName: "nvidia.com/TU104GL_Tesla_T4"}},
+		}
+
+		By("ignoring the rule on create")
+		Expect(wh.ValidateCreate(ctx, false, cr)).To(Succeed())
+
+		By("denying a user that is not in the approvers group")
+		userCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "user", Groups: []string{"users"}},
+		}})
+		Expect(wh.ValidateUpdate(userCtx, false, cr, hco)).To(MatchError(ContainSubstring("permittedHostDevices must be approved")))
+
+		By("allowing a user in the approvers group")
+		approverCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "approver", Groups: []string{"users", "security-approvers"}},
+		}})
+		Expect(wh.ValidateUpdate(approverCtx, false, cr, hco)).To(Succeed())
+	})
+
+	It("should expose the existing HyperConverged CR as oldObject", func() {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": noEvictionChangeRule}))
+
+		cr := hco.DeepCopy()
+		cr.Spec.EvictionStrategy = ptr.To(kubevirtcorev1.EvictionStrategyNone)
+		Expect(wh.ValidateCreate(ctx, false, cr)).To(Succeed())
+		Expect(wh.ValidateUpdate(ctx, false, cr, hco)).To(MatchError(ContainSubstring(
+			`rule "eviction-strategy": failed expression: oldObject == null || object.spec.evictionStrategy != 'None'`)))
+	})
+
+	It("should evaluate the rules from all the ConfigMap keys", func() {
+		wh := newHandler(newRulesConfigMap(map[string]string{
+			"a.yaml": noPostCopyRule,
+			"b.yaml": tlsProfileRule,
+		}))
+
+		cr := hco.DeepCopy()
+		cr.Spec.TLSSecurityProfile = nil
+		cr.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(true)
+		Expect(wh.ValidateCreate(ctx, false, cr)).To(MatchError(ContainSubstring(`rule "no-post-copy"`)))
+
+		cr.Spec.LiveMigrationConfig.AllowPostCopy = nil
+		Expect(getWarnings(wh.ValidateCreate(ctx, false, cr))).To(ConsistOf(ContainSubstring(`rule "tls-profile"`)))
+	})
+
+	DescribeTable("should fail closed for invalid Deny rules", func(rules, expectedMsg string) {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": rules}))
+		Expect(wh.ValidateCreate(ctx, false, hco)).To(MatchError(ContainSubstring(expectedMsg)))
+	},
+		Entry("missing name", `[{"expression": "true"}]`, `rule "" is invalid: missing name`),
+		Entry("syntax error", `[{"name": "a", "expression": "object.spec."}]`, `rule "a" is invalid: ERROR`),
+		Entry("non-bool expression", `[{"name": "a", "expression": "'abc'"}]`, `rule "a" is invalid: the expression must return a bool, but it returns string`),
+		Entry("unknown operation", `[{"name": "a", "expression": "true", "operations": ["DELETE"]}]`, `rule "a" is invalid: unsupported operation "DELETE"`),
+		Entry("missing field", `[{"name": "a", "expression": "object.spec.notAField == 'x'"}]`, `rule "a" can't be evaluated: no such key: notAField`),
+		Entry("dyn non-bool result", `[{"name": "a", "expression": "object.spec.uninstallStrategy"}]`, `rule "a" can't be evaluated: expected a bool result, but got string`),
+	)
+
+	DescribeTable("should only warn for invalid Warn rules", func(rules, expectedMsg string) {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": rules}))
+		Expect(getWarnings(wh.ValidateCreate(ctx, false, hco))).To(ConsistOf(ContainSubstring(expectedMsg)))
+	},
+		Entry("syntax error", `[{"name": "a", "expression": "object.spec.", "action": "Warn"}]`, `rule "a" is invalid: ERROR`),
+		Entry("unknown operation", `[{"name": "a", "expression": "true", "action": "Warn", "operations": ["DELETE"]}]`, `rule "a" is invalid: unsupported operation "DELETE"`),
+		Entry("missing field", `[{"name": "a", "expression": "object.spec.notAField == 'x'", "action": "Warn"}]`, `rule "a" can't be evaluated: no such key: notAField`),
+	)
+
+	DescribeTable("should only warn for rules with an unknown action", func(rules, expectedMsg string) {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": rules}))
+		Expect(getWarnings(wh.ValidateCreate(ctx, false, hco))).To(ConsistOf(ContainSubstring(expectedMsg)))
+	},
+		Entry("not a list of rules", `name: a`, `rule "rules.yaml" is invalid: can't read the rules`),
+		Entry("unknown action", `[{"name": "a", "expression": "true", "action": "Audit"}]`, `rule "a" is invalid: unknown action "Audit"`),
+	)
+
+	It("should not evaluate the rules for the requests of HCO", func() {
+		wh := newHandler(newRulesConfigMap(map[string]string{"rules.yaml": noPostCopyRule}))
+
+		cr := hco.DeepCopy()
+		cr.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(true)
+
+		hcoCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "system:serviceaccount:" + HcoValidNamespace + ":" + hcoutil.HCOServiceAccountName},
+		}})
+		Expect(wh.ValidateUpdate(hcoCtx, false, cr, hco)).To(Succeed())
+
+		userCtx := admission.NewContextWithRequest(ctx, admission.Request{AdmissionRequest: admissionv1.AdmissionRequest{
+			UserInfo: authenticationv1.UserInfo{Username: "system:serviceaccount:other-namespace:" + hcoutil.HCOServiceAccountName},
+		}})
+		Expect(wh.ValidateUpdate(userCtx, false, cr, hco)).To(MatchError(ContainSubstring(`rule "no-post-copy"`)))
+	})
+
+	It("should recompile the rules when the ConfigMap is modified", func() {
+		cm := newRulesConfigMap(map[string]string{"rules.yaml": noPostCopyRule})
+		wh := newHandler(cm)
+
+		cr := hco.DeepCopy()
+		cr.Spec.LiveMigrationConfig.AllowPostCopy = ptr.To(true)
+		Expect(wh.ValidateCreate(ctx, false, cr)).ToNot(Succeed())
+
+		cm.Data = map[string]string{"rules.yaml": tlsProfileRule}
+		Expect(wh.cli.Update(ctx, cm)).To(Succeed())
+		Expect(getWarnings(wh.ValidateCreate(ctx, false, cr))).To(ConsistOf(ContainSubstring(`rule "tls-profile"`)))
+	})
+})
@@ -0,0 +1,200 @@
+package validator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+)
+
+const (
+	// the number of namespaces to detail in the workload inventory; the rest are only counted
+	maxInventoryNamespaces = 10
+
+	// the allowed clock skew between the time in the deletion confirmation annotation, and the webhook time
+	deletionConfirmationClockSkew = time.Minute
+
+	deletionDeniedReason = "DeletionDenied"
+)
+
+// the order of the kinds in the workload inventory
+var inventoryKinds = []schema.GroupVersionKind{
+	kubevirtcorev1.SchemeGroupVersion.WithKind("VirtualMachine"),
+	kubevirtcorev1.SchemeGroupVersion.WithKind("VirtualMachineInstance"),
+	cdiv1beta1.SchemeGroupVersion.WithKind("DataVolume"),
+	corev1.SchemeGroupVersion.WithKind("PersistentVolumeClaim"),
+}
+
+// workloadInventory holds the number of objects of each of the inventoryKinds, per namespace
+type workloadInventory map[string][]int
+
+// getWorkloadInventory counts the virtualization workloads in the cluster. The PersistentVolumeClaims are only counted
+// in the namespaces with any VirtualMachine, VirtualMachineInstance or DataVolume. Kinds that can't be listed, e.g.
+// because their CRD is missing, are skipped.
+func (wh *WebhookHandler) getWorkloadInventory(ctx context.Context) workloadInventory {
+	inventory := workloadInventory{}
+	pvcIndex := len(inventoryKinds) - 1
+
+	for i, gvk := range inventoryKinds {
+		list := &metav1.PartialObjectMetadataList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+		if err := wh.cli.List(ctx, list); err != nil {
+			if !meta.IsNoMatchError(err) && !apierrors.IsNotFound(err) {
+				wh.logger.Error(err, "failed to list the workloads for the deletion inventory", "kind", gvk.Kind)
+			}
+			continue
+		}
+
+		for _, item := range list.Items {
+			counts, ok := inventory[item.Namespace]
+			if !ok {
+				if i == pvcIndex {
+					continue
+				}
+				counts = make([]int, len(inventoryKinds))
+				inventory[item.Namespace] = counts
+			}
+			counts[i]++
+		}
+	}
+
+	return inventory
+}
+
+func (inventory workloadInventory) String() string {
+	if len(inventory) == 0 {
+		return ""
+	}
+
+	namespaces := slices.Sorted(maps.Keys(inventory))
+	totals := make([]int, len(inventoryKinds))
+	details := make([]string, 0, min(len(namespaces), maxInventoryNamespaces))
+	for _, ns := range namespaces {
+		for i, count := range inventory[ns] {
+			totals[i] += count
+		}
+
+		if len(details) < maxInventoryNamespaces {
+			details = append(details, fmt.Sprintf("%s: %s", ns, formatInventoryCounts(inventory[ns])))
+		}
+	}
+
+	if more := len(namespaces) - len(details); more > 0 {
+		details = append(details, fmt.Sprintf("and %d more namespaces", more))
+	}
+
+	return fmt.Sprintf("the cluster contains %s (%s)", formatInventoryCounts(totals), strings.Join(details, "; "))
+}
+
+func formatInventoryCounts(counts []int) string {
+	parts := make([]string, 0, len(counts))
+	for i, count := range counts {
+		switch {
+		case count == 1:
+			parts = append(parts, fmt.Sprintf("1 %s", inventoryKinds[i].Kind))
+		case count > 1:
+			parts = append(parts, fmt.Sprintf("%d %ss", count, inventoryKinds[i].Kind))
+		}
+	}
+	return strings.Join(parts, ", ")
+}
+
+// rejectDeletion adds the workload inventory to the reason for rejecting the deletion of the HyperConverged CR, and
+// reports it in an event
+func (wh *WebhookHandler) rejectDeletion(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged, reason error) error {
+	err := reason
+	if inventory := wh.getWorkloadInventory(ctx).String(); inventory != "" {
+		var apiStatus apierrors.APIStatus
+		if errors.As(reason, &apiStatus) {
+			status := apiStatus.Status()
+			status.Message = fmt.Sprintf("%s; %s", status.Message, inventory)
+			err = &apierrors.StatusError{ErrStatus: status}
+		} else {
+			err = fmt.Errorf("%w; %s", reason, inventory)
+		}
+	}
+
+	if !dryrun {
+		wh.eventEmitter.EmitEvent(hc, corev1.EventTypeWarning, deletionDeniedReason, err.Error())
+	}
+
+	return err
+}
+
+// checkDeletionConfirmation checks that the deletion of the HyperConverged CR was confirmed, if the deletion
+// confirmation annotation is set. The confirmation is an annotation with the time of the confirmation, that is valid
+// for the duration in the deletion confirmation annotation.
+func checkDeletionConfirmation(hc *v1beta1.HyperConverged, now time.Time) error {
+	ttlValue, required := hc.Annotations[common.DeletionConfirmationTTLAnnotationName]
+	if !required {
+		return nil
+	}
+
+	ttl, err := parseDeletionConfirmationTTL(ttlValue)
+	if err != nil {
+		return err
+	}
+
+	confirmedAtValue, confirmed := hc.Annotations[common.DeletionConfirmedAtAnnotationName]
+	if !confirmed {
+		return fmt.Errorf("the deletion of the HyperConverged CR must be confirmed first: set the %s annotation to the current time, in RFC 3339 format, and delete the HyperConverged CR within %s",
+			common.DeletionConfirmedAtAnnotationName, ttl)
+	}
+
+	confirmedAt, err := time.Parse(time.RFC3339, confirmedAtValue)
+	if err != nil {
+		return fmt.Errorf("the value of the %s annotation must be a time in RFC 3339 format: %w", common.DeletionConfirmedAtAnnotationName, err)
+	}
+
+	if confirmedAt.After(now.Add(deletionConfirmationClockSkew)) {
+		return fmt.Errorf("the deletion confirmation time in the %s annotation, %s, is in the future", common.DeletionConfirmedAtAnnotationName, confirmedAtValue)
+	}
+
+	if expiresAt := confirmedAt.Add(ttl); now.After(expiresAt) {
+		return fmt.Errorf("the deletion confirmation in the %s annotation expired at %s; set it to the current time, and delete the HyperConverged CR within %s",
+			common.DeletionConfirmedAtAnnotationName, expiresAt.UTC().Format(time.RFC3339), ttl)
+	}
+
+	return nil
+}
+
+// validateDeletionConfirmationAnnotations rejects HyperConverged CRs with deletion confirmation annotations that could
+// never allow the deletion
+func validateDeletionConfirmationAnnotations(hc *v1beta1.HyperConverged) error {
+	if ttlValue, ok := hc.Annotations[common.DeletionConfirmationTTLAnnotationName]; ok {
+		if _, err := parseDeletionConfirmationTTL(ttlValue); err != nil {
+			return err
+		}
+	}
+
+	if confirmedAtValue, ok := hc.Annotations[common.DeletionConfirmedAtAnnotationName]; ok {
+		if _, err := time.Parse(time.RFC3339, confirmedAtValue); err != nil {
+			return fmt.Errorf("the value of the %s annotation must be a time in RFC 3339 format: %w", common.DeletionConfirmedAtAnnotationName, err)
+		}
+	}
+
+	return nil
+}
+
+func parseDeletionConfirmationTTL(value string) (time.Duration, error) {
+	ttl, err := time.ParseDuration(value)
+	if err != nil || ttl <= 0 {
+		return 0, fmt.Errorf("the value of the %s annotation must be a positive duration, e.g. 10m; got %q", common.DeletionConfirmationTTLAnnotationName, value)
+	}
+	return ttl, nil
+}
@@ -0,0 +1,208 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	cdiv1beta1 "kubevirt.io/containerized-data-importer-api/pkg/apis/core/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("HyperConverged deletion", func() {
+	var (
+		hco          *v1beta1.HyperConverged
+		ctx          context.Context
+		eventEmitter *commontestutils.EventEmitterMock
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		ctx = context.Background()
+		eventEmitter = commontestutils.NewEventEmitterMock()
+	})
+
+	newHandler := func(cli client.Client) *WebhookHandler {
+		wh := NewWebhookHandler(logger, cli, admission.NewDecoder(commontestutils.GetScheme()), HcoValidNamespace, true, nil)
+		wh.eventEmitter = eventEmitter
+		return wh
+	}
+
+	meta := func(ns, name string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{Namespace: ns, Name: name}
+	}
+
+	newWorkloads := func() []client.Object {
+		return []client.Object{
+			&kubevirtcorev1.VirtualMachine{ObjectMeta: meta("ns1", "vm1")},
+			&kubevirtcorev1.VirtualMachine{ObjectMeta: meta("ns1", "vm2")},
+			&kubevirtcorev1.VirtualMachineInstance{ObjectMeta: meta("ns1", "vm1")},
+			&cdiv1beta1.DataVolume{ObjectMeta: meta("ns1", "dv1")},
+			&corev1.PersistentVolumeClaim{ObjectMeta: meta("ns1", "dv1")},
+			&corev1.PersistentVolumeClaim{ObjectMeta: meta("ns1", "other")},
+			&cdiv1beta1.DataVolume{ObjectMeta: meta("ns2", "dv2")},
+			&corev1.PersistentVolumeClaim{ObjectMeta: meta("ns2", "dv2")},
+			// not counted, as there are no virtualization workloads in this namespace
+			&corev1.PersistentVolumeClaim{ObjectMeta: meta("ns3", "pvc")},
+		}
+	}
+
+	const expectedInventory = "the cluster contains 2 VirtualMachines, 1 VirtualMachineInstance, 2 DataVolumes, 3 PersistentVolumeClaims " +
+		"(ns1: 2 VirtualMachines, 1 VirtualMachineInstance, 1 DataVolume, 2 PersistentVolumeClaims; ns2: 1 DataVolume, 1 PersistentVolumeClaim)"
+
+	Context("workload inventory", func() {
+		It("should count the workloads per namespace", func() {
+			cli := getFakeClient(hco)
+			for _, obj := range newWorkloads() {
+				Expect(cli.Create(ctx, obj)).To(Succeed())
+			}
+
+			Expect(newHandler(cli).getWorkloadInventory(ctx).String()).To(Equal(expectedInventory))
+		})
+
+		It("should return an empty string if there are no workloads", func() {
+			Expect(newHandler(getFakeClient(hco)).getWorkloadInventory(ctx).String()).To(BeEmpty())
+		})
+
+		It("should only detail the first namespaces", func() {
+			inventory := workloadInventory{}
+			for i := range maxInventoryNamespaces + 3 {
+				inventory[fmt.Sprintf("ns%02d", i)] = []int{1, 0, 0, 0}
+			}
+
+			str := inventory.String()
+			Expect(str).To(HavePrefix("the cluster contains 13 VirtualMachines (ns00: 1 VirtualMachine; "))
+			Expect(str).To(ContainSubstring("ns09: 1 VirtualMachine; and 3 more namespaces)"))
+			Expect(str).ToNot(ContainSubstring("ns10"))
+		})
+
+		It("should add the inventory to the rejection, and emit an event", func() {
+			cli := getFakeClient(hco)
+			for _, obj := range newWorkloads() {
+				Expect(cli.Create(ctx, obj)).To(Succeed())
+			}
+			cli.InitiateDeleteErrors(func(obj client.Object) error {
+				if obj.GetObjectKind().GroupVersionKind().Kind == "KubeVirt" {
+					return ErrFakeKvError
+				}
+				return nil
+			})
+
+			wh := newHandler(cli)
+
+			err := wh.ValidateDelete(ctx, false, hco)
+			Expect(err).To(MatchError(ErrFakeKvError))
+			Expect(err).To(MatchError(ContainSubstring(expectedInventory)))
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+				EventType: corev1.EventTypeWarning,
+				Reason:    deletionDeniedReason,
+				Msg:       err.Error(),
+			}})).To(BeTrue())
+
+			By("not emitting an event on dry-run")
+			eventEmitter.Reset()
+			Expect(wh.ValidateDelete(ctx, true, hco)).To(MatchError(ErrFakeKvError))
+			Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+		})
+
+		It("should keep the API status of the rejection", func() {
+			cli := getFakeClient(hco)
+			for _, obj := range newWorkloads() {
+				Expect(cli.Create(ctx, obj)).To(Succeed())
+			}
+			cli.InitiateDeleteErrors(func(obj client.Object) error {
+				if obj.GetObjectKind().GroupVersionKind().Kind == "KubeVirt" {
+					return apierrors.NewForbidden(schema.GroupResource{Group: "kubevirt.io", Resource: "kubevirts"}, "kubevirt-kubevirt-hyperconverged", ErrFakeKvError)
+				}
+				return nil
+			})
+
+			err := newHandler(cli).ValidateDelete(ctx, false, hco)
+			Expect(apierrors.IsForbidden(err)).To(BeTrue())
+			Expect(err).To(MatchError(ContainSubstring("fake KubeVirt error; " + expectedInventory)))
+		})
+	})
+
+	Context("deletion confirmation", func() {
+		setAnnotations := func(ttl, confirmedAt string) {
+			hco.Annotations = map[string]string{}
+			if ttl != "" {
+				hco.Annotations[common.DeletionConfirmationTTLAnnotationName] = ttl
+			}
+			if confirmedAt != "" {
+				hco.Annotations[common.DeletionConfirmedAtAnnotationName] = confirmedAt
+			}
+		}
+
+		formatTime := func(d time.Duration) string {
+			return time.Now().Add(d).UTC().Format(time.RFC3339)
+		}
+
+		DescribeTable("should allow the deletion", func(ttl, confirmedAt string) {
+			setAnnotations(ttl, confirmedAt)
+			Expect(newHandler(getFakeClient(hco)).ValidateDelete(ctx, false, hco)).To(Succeed())
+			Expect(eventEmitter.CheckNoEventEmitted()).To(BeTrue())
+		},
+			Entry("if the confirmation is not required", "", ""),
+			Entry("if the confirmation is not required, even with a stale confirmation", "", formatTime(-time.Hour)),
+			Entry("if the deletion was confirmed within the TTL", "10m", formatTime(-5*time.Minute)),
+			Entry("if the confirmation time is slightly in the future", "10m", formatTime(30*time.Second)),
+		)
+
+		DescribeTable("should reject the deletion", func(ttl, confirmedAt, expectedMsg string) {
+			setAnnotations(ttl, confirmedAt)
+			cli := getFakeClient(hco)
+			for _, obj := range newWorkloads() {
+				Expect(cli.Create(ctx, obj)).To(Succeed())
+			}
+
+			err := newHandler(cli).ValidateDelete(ctx, false, hco)
+			Expect(err).To(MatchError(ContainSubstring(expectedMsg)))
+			Expect(err).To(MatchError(ContainSubstring(expectedInventory)))
+			Expect(eventEmitter.CheckEvents([]commontestutils.MockEvent{{
+				EventType: corev1.EventTypeWarning,
+				Reason:    deletionDeniedReason,
+				Msg:       err.Error(),
+			}})).To(BeTrue())
+
+			By("keeping the operands")
+			Expect(cli.Get(ctx, client.ObjectKey{Namespace: hco.Namespace, Name: "kubevirt-kubevirt-hyperconverged"}, &kubevirtcorev1.KubeVirt{})).To(Succeed())
+		},
+			Entry("if the deletion was not confirmed", "10m", "",
+				"the deletion of the HyperConverged CR must be confirmed first: set the hco.kubevirt.io/deletion-confirmed-at annotation to the current time, in RFC 3339 format, and delete the HyperConverged CR within 10m0s"),
+			Entry("if the confirmation expired", "10m", formatTime(-15*time.Minute),
+				"the deletion confirmation in the hco.kubevirt.io/deletion-confirmed-at annotation expired at"),
+			Entry("if the confirmation time is in the future", "10m", formatTime(time.Hour),
+				"is in the future"),
+			Entry("if the confirmation time is invalid", "10m", "yesterday",
+				"the value of the hco.kubevirt.io/deletion-confirmed-at annotation must be a time in RFC 3339 format"),
+			Entry("if the TTL is invalid", "forever", formatTime(0),
+				`the value of the hco.kubevirt.io/deletion-confirmation-ttl annotation must be a positive duration, e.g. 10m; got "forever"`),
+		)
+
+		DescribeTable("should validate the annotations on create and update", func(ttl, confirmedAt string, matcher OmegaMatcher) {
+			setAnnotations(ttl, confirmedAt)
+			wh := newHandler(getFakeClient(commontestutils.NewHco()))
+
+			Expect(wh.ValidateCreate(ctx, false, hco)).To(matcher)
+			Expect(wh.ValidateUpdate(ctx, false, hco, commontestutils.NewHco())).To(matcher)
+		},
+			Entry("valid annotations", "1h", formatTime(0), Succeed()),
+			Entry("invalid TTL", "-1h", "", MatchError(ContainSubstring("must be a positive duration"))),
+			Entry("zero TTL", "0s", "", MatchError(ContainSubstring("must be a positive duration"))),
+			Entry("invalid confirmation time", "", "now", MatchError(ContainSubstring("must be a time in RFC 3339 format"))),
+		)
+	})
+})
@@ -0,0 +1,198 @@
+package validator
+
+import (
+	"fmt"
+	"net/url"
+	"regexp"
+	"slices"
+	"time"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/alertoverrides"
+	hcorules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+	observabilityrules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
+)
+
+// the minimal duration of an alert silence. The observability controller checks the silences every hour, and renews
+// them when less than two hours are left, so a shorter silence would be renewed on every check.
+const minimalSilenceDuration = 2 * time.Hour
+
+func validateMonitoring(hc *v1beta1.HyperConverged) error {
+	if hc.Spec.Monitoring == nil {
+		return nil
+	}
+
+	if err := validateAlertSilences(hc.Spec.Monitoring.Silences); err != nil {
+		return err
+	}
+
+	if err := validateAlertmanager(hc.Spec.Monitoring.Alertmanager); err != nil {
+		return err
+	}
+
+	if runbookBaseURL := hc.Spec.Monitoring.RunbookBaseURL; runbookBaseURL != "" {
+		if err := validateHTTPURL(runbookBaseURL); err != nil {
+			return fmt.Errorf("spec.monitoring.runbookBaseURL: %w", err)
+		}
+	}
+
+	return validateAlertOverrides(hc.Spec.Monitoring.Alerts)
+}
+
+func validateAlertmanager(amConfig *v1beta1.AlertmanagerConfig) error {
+	if amConfig == nil {
+		return nil
+	}
+
+	const field = "spec.monitoring.alertmanager"
+
+	if (amConfig.URL == "") == (amConfig.ServiceSelector == nil) {
+		return fmt.Errorf("%s: exactly one of url or serviceSelector must be set", field)
+	}
+
+	if amConfig.URL != "" {
+		if err := validateHTTPURL(amConfig.URL); err != nil {
+			return fmt.Errorf("%s.url: %w", field, err)
+		}
+	}
+
+	if selector := amConfig.ServiceSelector; selector != nil {
+		if selector.Namespace == "" {
+			return fmt.Errorf("%s.serviceSelector.namespace: must not be empty", field)
+		}
+
+		if len(selector.MatchLabels) == 0 {
+			return fmt.Errorf("%s.serviceSelector.matchLabels: must not be empty", field)
+		}
+	}
+
+	return nil
+}
+
+func validateAlertSilences(silences []v1beta1.AlertSilence) error {
+	names := make(map[string]struct{}, len(silences))
+	for i, silence := range silences {
+		field := fmt.Sprintf("spec.monitoring.silences[%d]", i)
+
+		if silence.Name == "" {
+			return fmt.Errorf("%s.name: must not be empty", field)
+		}
+
+		if _, exists := names[silence.Name]; exists {
+			return fmt.Errorf("%s.name: the %s silence name is not unique", field, silence.Name)
+		}
+		names[silence.Name] = struct{}{}
+
+		if silence.Comment == "" {
+			return fmt.Errorf("%s.comment: must not be empty", field)
+		}
+
+		if silence.Duration != nil && silence.Duration.Duration < minimalSilenceDuration {
+			return fmt.Errorf("%s.duration: must be at least %v", field, minimalSilenceDuration)
+		}
+
+		if len(silence.Matchers) == 0 {
+			return fmt.Errorf("%s.matchers: must not be empty", field)
+		}
+
+		for j, matcher := range silence.Matchers {
+			if err := validateAlertSilenceMatcher(matcher); err != nil {
+				return fmt.Errorf("%s.matchers[%d]: %w", field, j, err)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateAlertSilenceMatcher(matcher v1beta1.AlertSilenceMatcher) error {
+	if matcher.Name == "" {
+		return fmt.Errorf("the label name must not be empty")
+	}
+
+	switch matcher.Operator {
+	case "", v1beta1.AlertSilenceMatchEqual, v1beta1.AlertSilenceMatchNotEqual:
+	case v1beta1.AlertSilenceMatchRegex, v1beta1.AlertSilenceMatchNotRegex:
+		// Alertmanager anchors the regular expressions
+		if _, err := regexp.Compile("^(?:" + matcher.Value + ")$"); err != nil {
+			return fmt.Errorf("invalid regular expression %q: %w", matcher.Value, err)
+		}
+	default:
+		return fmt.Errorf("unknown operator %q", matcher.Operator)
+	}
+
+	return nil
+}
+
+// the name of a Prometheus label
+var labelNameRegex = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_]*$`)
+
+func validateAlertOverrides(overrides []v1beta1.AlertOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+
+	tunableAlerts := alertoverrides.Merge(hcorules.TunableAlerts(), observabilityrules.TunableAlerts())
+
+	names := make(map[string]struct{}, len(overrides))
+	for i, override := range overrides {
+		field := fmt.Sprintf("spec.monitoring.alerts[%d]", i)
+
+		threshold, known := tunableAlerts[override.Name]
+		if !known {
+			return fmt.Errorf("%s.name: unknown alert %q", field, override.Name)
+		}
+
+		if _, exists := names[override.Name]; exists {
+			return fmt.Errorf("%s.name: the %s alert is overridden more than once", field, override.Name)
+		}
+		names[override.Name] = struct{}{}
+
+		switch override.Severity {
+		case "", "critical", "warning", "info":
+		default:
+			return fmt.Errorf("%s.severity: unknown severity %q", field, override.Severity)
+		}
+
+		if override.For != nil && override.For.Duration < 0 {
+			return fmt.Errorf("%s.for: must not be negative", field)
+		}
+
+		if override.Threshold != nil {
+			if threshold == nil {
+				return fmt.Errorf("%s.threshold: the %s alert has no tunable threshold", field, override.Name)
+			}
+
+			if _, err := threshold.ParseThreshold(*override.Threshold); err != nil {
+				return fmt.Errorf("%s.threshold: %w", field, err)
+			}
+		}
+
+		if override.RunbookURL != "" {
+			if err := validateHTTPURL(override.RunbookURL); err != nil {
+				return fmt.Errorf("%s.runbookURL: %w", field, err)
+			}
+		}
+
+		for key := range override.Labels {
+			if !labelNameRegex.MatchString(key) {
+				return fmt.Errorf("%s.labels: invalid label name %q", field, key)
+			}
+
+			if slices.Contains(alertoverrides.ReservedLabels, key) {
+				return fmt.Errorf("%s.labels: the %s label can't be overridden", field, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func validateHTTPURL(value string) error {
+	u, err := url.Parse(value)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("must be an http or https URL")
+	}
+
+	return nil
+}
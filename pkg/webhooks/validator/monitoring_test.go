@@ -0,0 +1,185 @@
+package validator
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+)
+
+var _ = Describe("validate monitoring", func() {
+	newSilence := func(name string) v1beta1.AlertSilence {
+		return v1beta1.AlertSilence{
+			Name: name,
+			Matchers: []v1beta1.AlertSilenceMatcher{
+				{Name: "alertname", Value: "KubeVirtNoAvailableNodesToRunVMs"},
+				{Name: "namespace", Value: "test-.*", Operator: v1beta1.AlertSilenceMatchRegex},
+			},
+			Duration: &metav1.Duration{Duration: 24 * time.Hour},
+			Comment:  "planned maintenance",
+		}
+	}
+
+	newHCWithSilences := func(silences ...v1beta1.AlertSilence) *v1beta1.HyperConverged {
+		hc := commontestutils.NewHco()
+		hc.Spec.Monitoring = &v1beta1.MonitoringConfig{Silences: silences}
+		return hc
+	}
+
+	It("should allow a missing monitoring configuration", func() {
+		Expect(validateMonitoring(commontestutils.NewHco())).To(Succeed())
+	})
+
+	It("should allow valid silences", func() {
+		noDuration := newSilence("no-duration")
+		noDuration.Duration = nil
+
+		Expect(validateMonitoring(newHCWithSilences(newSilence("first"), newSilence("second"), noDuration))).To(Succeed())
+	})
+
+	DescribeTable("should reject invalid silences", func(modify func(*v1beta1.AlertSilence), expectedErr string) {
+		silence := newSilence("invalid")
+		modify(&silence)
+
+		err := validateMonitoring(newHCWithSilences(newSilence("valid"), silence))
+		Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+	},
+		Entry("empty name", func(s *v1beta1.AlertSilence) { s.Name = "" },
+			"spec.monitoring.silences[1].name: must not be empty"),
+		Entry("duplicated name", func(s *v1beta1.AlertSilence) { s.Name = "valid" },
+			"spec.monitoring.silences[1].name: the valid silence name is not unique"),
+		Entry("empty comment", func(s *v1beta1.AlertSilence) { s.Comment = "" },
+			"spec.monitoring.silences[1].comment: must not be empty"),
+		Entry("too short duration", func(s *v1beta1.AlertSilence) { s.Duration = &metav1.Duration{Duration: time.Hour} },
+			"spec.monitoring.silences[1].duration: must be at least 2h0m0s"),
+		Entry("no matchers", func(s *v1beta1.AlertSilence) { s.Matchers = nil },
+			"spec.monitoring.silences[1].matchers: must not be empty"),
+		Entry("empty label name", func(s *v1beta1.AlertSilence) { s.Matchers[0].Name = "" },
+			"spec.monitoring.silences[1].matchers[0]: the label name must not be empty"),
+		Entry("unknown operator", func(s *v1beta1.AlertSilence) { s.Matchers[0].Operator = "==" },
+			`spec.monitoring.silences[1].matchers[0]: unknown operator "=="`),
+		Entry("invalid regular expression", func(s *v1beta1.AlertSilence) { s.Matchers[1].Value = "test-(" },
+			`spec.monitoring.silences[1].matchers[1]: invalid regular expression "test-("`),
+		Entry("invalid negative regular expression", func(s *v1beta1.AlertSilence) {
+			s.Matchers[1].Operator = v1beta1.AlertSilenceMatchNotRegex
+			s.Matchers[1].Value = "[a-"
+		},
+			`spec.monitoring.silences[1].matchers[1]: invalid regular expression "[a-"`),
+	)
+
+	Context("alertmanager", func() {
+		newHCWithAlertmanager := func(amConfig *v1beta1.AlertmanagerConfig) *v1beta1.HyperConverged {
+			hc := commontestutils.NewHco()
+			hc.Spec.Monitoring = &v1beta1.MonitoringConfig{Alertmanager: amConfig}
+			return hc
+		}
+
+		selector := &v1beta1.AlertmanagerServiceSelector{
+			Namespace:   "monitoring",
+			MatchLabels: map[string]string{"app.kubernetes.io/name": "alertmanager"},
+		}
+
+		DescribeTable("should allow a valid alertmanager configuration", func(amConfig *v1beta1.AlertmanagerConfig) {
+			Expect(validateMonitoring(newHCWithAlertmanager(amConfig))).To(Succeed())
+		},
+			Entry("url", &v1beta1.AlertmanagerConfig{URL: "https://alertmanager.monitoring.svc:9093", BasicAuthSecret: "alertmanager-auth"}),
+			Entry("service selector", &v1beta1.AlertmanagerConfig{
+				ServiceSelector: selector,
+				TLS:             &v1beta1.AlertmanagerTLSConfig{CAConfigMap: "alertmanager-ca", ClientCertSecret: "alertmanager-client-cert"},
+			}),
+		)
+
+		DescribeTable("should reject an invalid alertmanager configuration", func(amConfig *v1beta1.AlertmanagerConfig, expectedErr string) {
+			err := validateMonitoring(newHCWithAlertmanager(amConfig))
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("no url nor service selector", &v1beta1.AlertmanagerConfig{},
+				"spec.monitoring.alertmanager: exactly one of url or serviceSelector must be set"),
+			Entry("both url and service selector", &v1beta1.AlertmanagerConfig{URL: "http://alertmanager:9093", ServiceSelector: selector},
+				"spec.monitoring.alertmanager: exactly one of url or serviceSelector must be set"),
+			Entry("invalid url", &v1beta1.AlertmanagerConfig{URL: "alertmanager:9093"},
+				"spec.monitoring.alertmanager.url: must be an http or https URL"),
+			Entry("service selector without labels", &v1beta1.AlertmanagerConfig{ServiceSelector: &v1beta1.AlertmanagerServiceSelector{Namespace: "monitoring"}},
+				"spec.monitoring.alertmanager.serviceSelector.matchLabels: must not be empty"),
+		)
+	})
+
+	It("should not validate the value as a regular expression for the equality operators", func() {
+		silence := newSilence("equal")
+		silence.Matchers[1].Operator = v1beta1.AlertSilenceMatchNotEqual
+		silence.Matchers[1].Value = "test-("
+
+		Expect(validateMonitoring(newHCWithSilences(silence))).To(Succeed())
+	})
+
+	Context("alert overrides", func() {
+		newHCWithAlerts := func(overrides ...v1beta1.AlertOverride) *v1beta1.HyperConverged {
+			hc := commontestutils.NewHco()
+			hc.Spec.Monitoring = &v1beta1.MonitoringConfig{Alerts: overrides}
+			return hc
+		}
+
+		It("should allow valid overrides", func() {
+			hc := newHCWithAlerts(
+				v1beta1.AlertOverride{Name: "HCOInstallationIncomplete", For: &metav1.Duration{Duration: 2 * time.Hour}, Severity: "warning"},
+				v1beta1.AlertOverride{Name: "HighCPUWorkload", Threshold: ptr.To("0.8"), Labels: map[string]string{"team": "virt"}},
+				v1beta1.AlertOverride{Name: "HighNodeCPUFrequency", Disabled: true},
+				v1beta1.AlertOverride{Name: "HCOUpgradeStuck", Threshold: ptr.To("240"), RunbookURL: "https://wiki.example.com/HCOUpgradeStuck"},
+			)
+
+			Expect(validateMonitoring(hc)).To(Succeed())
+		})
+
+		DescribeTable("should reject invalid overrides", func(override v1beta1.AlertOverride, expectedErr string) {
+			hc := newHCWithAlerts(v1beta1.AlertOverride{Name: "KubeVirtCRModified", Severity: "critical"}, override)
+
+			err := validateMonitoring(hc)
+			Expect(err).To(MatchError(ContainSubstring(expectedErr)))
+		},
+			Entry("unknown alert", v1beta1.AlertOverride{Name: "UnknownAlert"},
+				`spec.monitoring.alerts[1].name: unknown alert "UnknownAlert"`),
+			Entry("duplicated alert", v1beta1.AlertOverride{Name: "KubeVirtCRModified"},
+				"spec.monitoring.alerts[1].name: the KubeVirtCRModified alert is overridden more than once"),
+			Entry("unknown severity", v1beta1.AlertOverride{Name: "HighCPUWorkload", Severity: "major"},
+				`spec.monitoring.alerts[1].severity: unknown severity "major"`),
+			Entry("negative for duration", v1beta1.AlertOverride{Name: "HighCPUWorkload", For: &metav1.Duration{Duration: -time.Minute}},
+				"spec.monitoring.alerts[1].for: must not be negative"),
+			Entry("threshold of an alert without a threshold", v1beta1.AlertOverride{Name: "HCOInstallationIncomplete", Threshold: ptr.To("1")},
+				"spec.monitoring.alerts[1].threshold: the HCOInstallationIncomplete alert has no tunable threshold"),
+			Entry("out of range threshold", v1beta1.AlertOverride{Name: "HighCPUWorkload", Threshold: ptr.To("2")},
+				"spec.monitoring.alerts[1].threshold: the threshold must be between 0.01 and 1"),
+			Entry("out of range upgrade duration threshold", v1beta1.AlertOverride{Name: "HCOUpgradeStuck", Threshold: ptr.To("5")},
+				"spec.monitoring.alerts[1].threshold: the threshold must be between 10 and 10080"),
+			Entry("invalid label name", v1beta1.AlertOverride{Name: "HighCPUWorkload", Labels: map[string]string{"team-name": "virt"}},
+				`spec.monitoring.alerts[1].labels: invalid label name "team-name"`),
+			Entry("reserved label", v1beta1.AlertOverride{Name: "HighCPUWorkload", Labels: map[string]string{"severity": "critical"}},
+				"spec.monitoring.alerts[1].labels: the severity label can't be overridden"),
+			Entry("invalid runbook URL", v1beta1.AlertOverride{Name: "HighCPUWorkload", RunbookURL: "wiki/HighCPUWorkload"},
+				"spec.monitoring.alerts[1].runbookURL: must be an http or https URL"),
+		)
+	})
+
+	Context("runbook base URL", func() {
+		DescribeTable("should validate the runbook base URL", func(runbookBaseURL string, matcher types.GomegaMatcher) {
+			hc := commontestutils.NewHco()
+			hc.Spec.Monitoring = &v1beta1.MonitoringConfig{RunbookBaseURL: runbookBaseURL}
+
+			Expect(validateMonitoring(hc)).To(matcher)
+		},
+			Entry("https URL", "https://wiki.example.com/runbooks", Succeed()),
+			Entry("http URL", "http://wiki.example.com/runbooks/", Succeed()),
+			Entry("no scheme", "wiki.example.com/runbooks",
+				MatchError("spec.monitoring.runbookBaseURL: must be an http or https URL")),
+			Entry("no host", "https:///runbooks",
+				MatchError("spec.monitoring.runbookBaseURL: must be an http or https URL")),
+			Entry("unsupported scheme", "ftp://wiki.example.com/runbooks",
+				MatchError("spec.monitoring.runbookBaseURL: must be an http or https URL")),
+		)
+	})
+})
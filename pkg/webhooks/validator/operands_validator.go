@@ -0,0 +1,222 @@
+package validator
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/go-logr/logr"
+	admissionv1 "k8s.io/api/admission/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const (
+	operandEditProtectionDeny = "Deny"
+	operandEditProtectionWarn = "Warn"
+)
+
+var _ admission.Handler = &OperandsValidator{}
+
+// operandFieldHint points from a field of an operand CR, to the HyperConverged field it is reconciled from
+type operandFieldHint struct {
+	path    string
+	hcField string
+}
+
+type operandEditHints struct {
+	// annotation is the jsonpatch annotation of the operand, to use for fields that are not covered by hcFields
+	annotation string
+	fields     []operandFieldHint
+}
+
+// operandsEditHints maps the kind of the operand CRs HCO reconciles from the HyperConverged CR, to the HyperConverged
+// fields to use instead of modifying the operand CR directly
+var operandsEditHints = map[string]operandEditHints{
+	"KubeVirt": {
+		annotation: common.JSONPatchKVAnnotationName,
+		fields: []operandFieldHint{
+			{path: "/spec/configuration/developerConfiguration/featureGates", hcField: "spec.featureGates"},
+			{path: "/spec/configuration/developerConfiguration/logVerbosity", hcField: "spec.logVerbosityConfig.kubevirt"},
+			{path: "/spec/configuration/migrations", hcField: "spec.liveMigrationConfig"},
+			{path: "/spec/configuration/permittedHostDevices", hcField: "spec.permittedHostDevices"},
+			{path: "/spec/configuration/mediatedDevicesConfiguration", hcField: "spec.mediatedDevicesConfiguration"},
+			{path: "/spec/configuration/obsoleteCPUModels", hcField: "spec.obsoleteCPUs"},
+			{path: "/spec/configuration/minCPUModel", hcField: "spec.obsoleteCPUs"},
+			{path: "/spec/configuration/cpuModel", hcField: "spec.defaultCPUModel"},
+			{path: "/spec/configuration/defaultRuntimeClass", hcField: "spec.defaultRuntimeClass"},
+			{path: "/spec/configuration/vmStateStorageClass", hcField: "spec.vmStateStorageClass"},
+			{path: "/spec/configuration/virtualMachineOptions", hcField: "spec.virtualMachineOptions"},
+			{path: "/spec/configuration/tlsConfiguration", hcField: "spec.tlsSecurityProfile"},
+			{path: "/spec/configuration/evictionStrategy", hcField: "spec.evictionStrategy"},
+			{path: "/spec/configuration/ksmConfiguration", hcField: "spec.ksmConfiguration"},
+			{path: "/spec/configuration/liveUpdateConfiguration", hcField: "spec.liveUpdateConfiguration"},
+			{path: "/spec/configuration/network/binding", hcField: "spec.networkBinding"},
+			{path: "/spec/configuration/autoCPULimitNamespaceLabelSelector", hcField: "spec.resourceRequirements.autoCPULimitNamespaceLabelSelector"},
+			{path: "/spec/configuration/instancetype", hcField: "spec.instancetypeConfig"},
+			{path: "/spec/configuration/commonInstancetypesDeployment", hcField: "spec.CommonInstancetypesDeployment"},
+			{path: "/spec/configuration/apiConfiguration", hcField: "spec.tuningPolicy"},
+			{path: "/spec/configuration/webhookConfiguration", hcField: "spec.tuningPolicy"},
+			{path: "/spec/configuration/controllerConfiguration", hcField: "spec.tuningPolicy"},
+			{path: "/spec/configuration/handlerConfiguration", hcField: "spec.tuningPolicy"},
+			{path: "/spec/infra", hcField: "spec.infra"},
+			{path: "/spec/workloads", hcField: "spec.workloads"},
+			{path: "/spec/certificateRotateStrategy", hcField: "spec.certConfig"},
+			{path: "/spec/workloadUpdateStrategy", hcField: "spec.workloadUpdateStrategy"},
+			{path: "/spec/uninstallStrategy", hcField: "spec.uninstallStrategy"},
+		},
+	},
+	"CDI": {
+		annotation: common.JSONPatchCDIAnnotationName,
+		fields: []operandFieldHint{
+			{path: "/spec/config/tlsSecurityProfile", hcField: "spec.tlsSecurityProfile"},
+			{path: "/spec/config/podResourceRequirements", hcField: "spec.resourceRequirements.storageWorkloads"},
+			{path: "/spec/config/scratchSpaceStorageClass", hcField: "spec.scratchSpaceStorageClass"},
+			{path: "/spec/config/filesystemOverhead", hcField: "spec.filesystemOverhead"},
+			{path: "/spec/config/insecureRegistries", hcField: "spec.storageImport.insecureRegistries"},
+			{path: "/spec/config/logVerbosity", hcField: "spec.logVerbosityConfig.cdi"},
+			{path: "/spec/certConfig", hcField: "spec.certConfig"},
+			{path: "/spec/infra", hcField: "spec.infra"},
+			{path: "/spec/workloads", hcField: "spec.workloads"},
+			{path: "/spec/uninstallStrategy", hcField: "spec.uninstallStrategy"},
+		},
+	},
+	"NetworkAddonsConfig": {
+		annotation: common.JSONPatchCNAOAnnotationName,
+		fields: []operandFieldHint{
+			{path: "/spec/kubeSecondaryDNS", hcField: "spec.featureGates.deployKubeSecondaryDNS and spec.kubeSecondaryDNSNameServerIP"},
+			{path: "/spec/placementConfiguration/infra", hcField: "spec.infra"},
+			{path: "/spec/placementConfiguration/workloads", hcField: "spec.workloads"},
+			{path: "/spec/selfSignConfiguration", hcField: "spec.certConfig"},
+			{path: "/spec/tlsSecurityProfile", hcField: "spec.tlsSecurityProfile"},
+		},
+	},
+	"SSP": {
+		annotation: common.JSONPatchSSPAnnotationName,
+		fields: []operandFieldHint{
+			{path: "/spec/commonTemplates/namespace", hcField: "spec.commonTemplatesNamespace"},
+			{path: "/spec/commonTemplates/dataImportCronTemplates", hcField: "spec.dataImportCronTemplates"},
+			{path: "/spec/enableMultipleArchitectures", hcField: "spec.featureGates.enableMultiArchBootImageImport"},
+			{path: "/spec/tokenGenerationService", hcField: "spec.deployVmConsoleProxy"},
+			{path: "/spec/templateValidator/placement", hcField: "spec.infra"},
+			{path: "/spec/tlsSecurityProfile", hcField: "spec.tlsSecurityProfile"},
+		},
+	},
+}
+
+// OperandsValidator validates direct modifications of the operand CRs that HCO reconciles from the HyperConverged CR.
+// Such modifications are reverted by HCO anyway; depending on the operand edit protection annotation of the
+// HyperConverged CR, they are either rejected, or accepted with a warning, that points to the HyperConverged field or
+// to the jsonpatch annotation to use instead.
+type OperandsValidator struct {
+	logger    logr.Logger
+	cli       client.Client
+	decoder   admission.Decoder
+	namespace string
+}
+
+func NewOperandsValidator(logger logr.Logger, cli client.Client, decoder admission.Decoder, namespace string) *OperandsValidator {
+	return &OperandsValidator{
+		logger:    logger,
+		cli:       cli,
+		decoder:   decoder,
+		namespace: namespace,
+	}
+}
+
+func (ov *OperandsValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Update {
+		return admission.Allowed("ignoring other operations")
+	}
+
+	hints, ok := operandsEditHints[req.Kind.Kind]
+	if !ok {
+		return admission.Allowed("not an operand CR")
+	}
+
+	if isHCOServiceAccount(req.UserInfo.Username, ov.namespace) {
+		return admission.Allowed("modified by HCO")
+	}
+
+	hc := &v1beta1.HyperConverged{}
+	if err := ov.cli.Get(ctx, client.ObjectKey{Namespace: ov.namespace, Name: hcoutil.HyperConvergedName}, hc); err != nil {
+		if apierrors.IsNotFound(err) {
+			return admission.Allowed("the HyperConverged CR does not exist")
+		}
+		ov.logger.Error(err, "failed to read the HyperConverged CR")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	protection := hc.Annotations[common.OperandEditProtectionAnnotationName]
+	if protection != operandEditProtectionDeny && protection != operandEditProtectionWarn {
+		return admission.Allowed("the operand edit protection is disabled")
+	}
+
+	if isFrozenOperand(hc, req.Kind.Kind, req.Name) {
+		return admission.Allowed("the operand is frozen")
+	}
+
+	requested := &unstructured.Unstructured{}
+	if err := ov.decoder.DecodeRaw(req.Object, requested); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	exists := &unstructured.Unstructured{}
+	if err := ov.decoder.DecodeRaw(req.OldObject, exists); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	changes, err := operands.GetFieldDrift(exists, requested)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+
+	var msgs []string
+	for _, change := range changes {
+		if strings.HasPrefix(change.Path, "/spec/") {
+			msgs = append(msgs, fmt.Sprintf("%s: %s", change.Path, hints.getHint(change.Path)))
+		}
+	}
+
+	if len(msgs) == 0 {
+		// only the metadata was modified
+		return admission.Allowed("the spec was not modified")
+	}
+
+	msg := fmt.Sprintf("the %s CR is managed by the HyperConverged CR, and HCO reverts its modifications; modify the HyperConverged CR instead: %s",
+		req.Kind.Kind, strings.Join(msgs, "; "))
+
+	ov.logger.Info("direct modification of an operand CR", "kind", req.Kind.Kind, "name", req.Name, "user", req.UserInfo.Username, "protection", protection)
+
+	if protection == operandEditProtectionWarn {
+		return admission.Allowed("").WithWarnings(msg)
+	}
+
+	return admission.Denied(msg)
+}
+
+func (hints operandEditHints) getHint(path string) string {
+	for _, hint := range hints.fields {
+		if path == hint.path || strings.HasPrefix(path, hint.path+"/") {
+			return "use " + hint.hcField
+		}
+	}
+
+	return fmt.Sprintf("use the %s annotation", hints.annotation)
+}
+
+// isFrozenOperand checks if the operand is listed in the frozen operands annotation of the HyperConverged CR. HCO
+// does not reconcile the frozen operands, so they are meant to be modified directly.
+func isFrozenOperand(hc *v1beta1.HyperConverged, kind, name string) bool {
+	keys, _ := common.ParseFrozenOperands(hc.Annotations[common.FrozenOperandsAnnotationName])
+	return slices.Contains(keys, common.NewFrozenOperandKey(kind, name))
+}
@@ -0,0 +1,128 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	kubevirtcorev1 "kubevirt.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+)
+
+var _ = Describe("operands validator", func() {
+	var (
+		hco *v1beta1.HyperConverged
+		kv  *kubevirtcorev1.KubeVirt
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		hco.Annotations = map[string]string{common.OperandEditProtectionAnnotationName: operandEditProtectionDeny}
+
+		var err error
+		kv, err = handlers.NewKubeVirt(hco)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	newValidator := func(objs ...client.Object) *OperandsValidator {
+		cli := commontestutils.InitClient(objs)
+		return NewOperandsValidator(logger, cli, admission.NewDecoder(commontestutils.GetScheme()), HcoValidNamespace)
+	}
+
+	newRequest := func(operation admissionv1.Operation, username string, requested, exists runtime.Object) admission.Request {
+		newJSON, err := json.Marshal(requested)
+		Expect(err).ToNot(HaveOccurred())
+		oldJSON, err := json.Marshal(exists)
+		Expect(err).ToNot(HaveOccurred())
+
+		return admission.Request{
+			AdmissionRequest: admissionv1.AdmissionRequest{
+				Operation: operation,
+				Kind:      metav1.GroupVersionKind{Group: "kubevirt.io", Version: "v1", Kind: "KubeVirt"},
+				Name:      kv.Name,
+				Namespace: kv.Namespace,
+				UserInfo:  authenticationv1.UserInfo{Username: username},
+				Object:    runtime.RawExtension{Raw: newJSON},
+				OldObject: runtime.RawExtension{Raw: oldJSON},
+			},
+		}
+	}
+
+	getModifiedKV := func() *kubevirtcorev1.KubeVirt {
+		modified := kv.DeepCopy()
+		modified.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster = ptr.To[uint32](42)
+		modified.Spec.Configuration.SMBIOSConfig = &kubevirtcorev1.SMBiosConfiguration{Manufacturer: "me"}
+		return modified
+	}
+
+	It("should reject direct modifications of the operand spec, and point to the HyperConverged fields", func() {
+		ov := newValidator(hco, kv)
+
+		resp := ov.Handle(context.Background(), newRequest(admissionv1.Update, "user", getModifiedKV(), kv))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Result.Code).To(Equal(int32(http.StatusForbidden)))
+		Expect(resp.Result.Message).To(HavePrefix("the KubeVirt CR is managed by the HyperConverged CR"))
+		Expect(resp.Result.Message).To(ContainSubstring("/spec/configuration/migrations/parallelMigrationsPerCluster: use spec.liveMigrationConfig"))
+		Expect(resp.Result.Message).To(ContainSubstring("/spec/configuration/smbios: use the %s annotation", common.JSONPatchKVAnnotationName))
+	})
+
+	It("should warn about direct modifications of the operand spec, if the protection is Warn", func() {
+		hco.Annotations[common.OperandEditProtectionAnnotationName] = operandEditProtectionWarn
+		ov := newValidator(hco, kv)
+
+		resp := ov.Handle(context.Background(), newRequest(admissionv1.Update, "user", getModifiedKV(), kv))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Warnings).To(ConsistOf(ContainSubstring("/spec/configuration/migrations/parallelMigrationsPerCluster: use spec.liveMigrationConfig")))
+	})
+
+	DescribeTable("should allow the request", func(modifyHC func(hc *v1beta1.HyperConverged), operation admissionv1.Operation, username string, modifyKV func(kv *kubevirtcorev1.KubeVirt)) {
+		objs := []client.Object{kv}
+		if modifyHC != nil {
+			modifyHC(hco)
+			objs = append(objs, hco)
+		}
+		ov := newValidator(objs...)
+
+		modified := getModifiedKV()
+		if modifyKV != nil {
+			modified = kv.DeepCopy()
+			modifyKV(modified)
+		}
+
+		resp := ov.Handle(context.Background(), newRequest(operation, username, modified, kv))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Warnings).To(BeEmpty())
+	},
+		Entry("if the protection annotation is missing", func(hc *v1beta1.HyperConverged) {
+			delete(hc.Annotations, common.OperandEditProtectionAnnotationName)
+		}, admissionv1.Update, "user", nil),
+		Entry("if the protection annotation has an unknown value", func(hc *v1beta1.HyperConverged) {
+			hc.Annotations[common.OperandEditProtectionAnnotationName] = "Audit"
+		}, admissionv1.Update, "user", nil),
+		Entry("if the HyperConverged CR does not exist", nil, admissionv1.Update, "user", nil),
+		Entry("if the operand is frozen", func(hc *v1beta1.HyperConverged) {
+			hc.Annotations[common.FrozenOperandsAnnotationName] = "Deployment/something, kubevirt/kubevirt-kubevirt-hyperconverged"
+		}, admissionv1.Update, "user", nil),
+		Entry("for HCO's own service account", func(_ *v1beta1.HyperConverged) {},
+			admissionv1.Update, "system:serviceaccount:"+HcoValidNamespace+":hyperconverged-cluster-operator", nil),
+		Entry("for other operations", func(_ *v1beta1.HyperConverged) {}, admissionv1.Delete, "user", nil),
+		Entry("if only the metadata was modified", func(_ *v1beta1.HyperConverged) {}, admissionv1.Update, "user",
+			func(kv *kubevirtcorev1.KubeVirt) {
+				kv.Finalizers = append(kv.Finalizers, "foregroundDeleteKubeVirt")
+				kv.Annotations = map[string]string{"some": "annotation"}
+			}),
+	)
+})
@@ -17,6 +17,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/component-helpers/scheduling/corev1/nodeaffinity"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -28,8 +29,9 @@ import (
 	sspv1beta3 "kubevirt.io/ssp-operator/api/v1beta3"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
-	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
 	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -47,6 +49,14 @@ func newValidationWarning(warnings []string) *ValidationWarning {
 	}
 }
 
+// newValidationWarningIfAny returns a ValidationWarning with the warnings, or nil if there are no warnings
+func newValidationWarningIfAny(warnings []string) error {
+	if len(warnings) > 0 {
+		return newValidationWarning(warnings)
+	}
+	return nil
+}
+
 func (v *ValidationWarning) Error() string {
 	return ""
 }
@@ -61,6 +71,9 @@ type WebhookHandler struct {
 	namespace   string
 	isOpenshift bool
 	decoder     admission.Decoder
+	rulesCache  *admissionRulesCache
+	// reports the rejected deletions of the HyperConverged CR
+	eventEmitter hcoutil.EventEmitter
 }
 
 var hcoTLSConfigCache *openshiftconfigv1.TLSSecurityProfile
@@ -68,11 +81,13 @@ var hcoTLSConfigCache *openshiftconfigv1.TLSSecurityProfile
 func NewWebhookHandler(logger logr.Logger, cli client.Client, decoder admission.Decoder, namespace string, isOpenshift bool, hcoTLSSecurityProfile *openshiftconfigv1.TLSSecurityProfile) *WebhookHandler {
 	hcoTLSConfigCache = hcoTLSSecurityProfile
 	return &WebhookHandler{
-		logger:      logger,
-		cli:         cli,
-		namespace:   namespace,
-		isOpenshift: isOpenshift,
-		decoder:     decoder,
+		logger:       logger,
+		cli:          cli,
+		namespace:    namespace,
+		isOpenshift:  isOpenshift,
+		decoder:      decoder,
+		rulesCache:   &admissionRulesCache{},
+		eventEmitter: hcoutil.GetEventEmitter(),
 	}
 }
 
@@ -134,7 +149,7 @@ func (wh *WebhookHandler) Handle(ctx context.Context, req admission.Request) adm
 	return admission.Allowed("")
 }
 
-func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
+func (wh *WebhookHandler) ValidateCreate(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating create", "name", hc.Name, "namespace:", hc.Namespace)
 
 	if err := wh.validateCertConfig(hc); err != nil {
@@ -157,10 +172,21 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
-	if err := wh.validateFeatureGatesOnCreate(hc); err != nil {
+	if err := validateMonitoring(hc); err != nil {
+		return err
+	}
+
+	if err := validateDeletionConfirmationAnnotations(hc); err != nil {
 		return err
 	}
 
+	warnings, _, err := wh.validateJSONPatchAnnotations(hc, nil)
+	if err != nil {
+		return err
+	}
+
+	warnings = append(warnings, wh.validateFeatureGatesOnCreate(hc)...)
+
 	if err := wh.validateAffinity(hc); err != nil {
 		return err
 	}
@@ -177,15 +203,21 @@ func (wh *WebhookHandler) ValidateCreate(_ context.Context, dryrun bool, hc *v1b
 		return err
 	}
 
-	if _, _, err := handlers.NewSSP(hc); err != nil {
+	if _, err := newSSPFromStatus(hc); err != nil {
 		return err
 	}
 
+	ruleWarnings, err := wh.evaluateAdmissionRules(ctx, admissionv1.Create, hc, nil)
+	if err != nil {
+		return err
+	}
+	warnings = append(warnings, ruleWarnings...)
+
 	if !dryrun {
 		hcoTLSConfigCache = hc.Spec.TLSSecurityProfile
 	}
 
-	return nil
+	return newValidationWarningIfAny(warnings)
 }
 
 func (wh *WebhookHandler) getOperands(requested *v1beta1.HyperConverged) (*kubevirtcorev1.KubeVirt, *cdiv1beta1.CDI, *networkaddonsv1.NetworkAddonsConfig, error) {
@@ -211,6 +243,38 @@ func (wh *WebhookHandler) getOperands(requested *v1beta1.HyperConverged) (*kubev
 	return kv, cdi, cna, nil
 }
 
+// renderOperands returns the operand CRs, as HCO would generate them from the requested HyperConverged CR. SSP is
+// only rendered on OpenShift, for the node architectures in the HyperConverged status, as the webhook does not watch
+// the nodes.
+func (wh *WebhookHandler) renderOperands(requested *v1beta1.HyperConverged) ([]client.Object, error) {
+	kv, cdi, cna, err := wh.getOperands(requested)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []client.Object{
+		kv,
+		cdi,
+		cna,
+	}
+
+	if wh.isOpenshift {
+		ssp, err := newSSPFromStatus(requested)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, ssp)
+	}
+
+	return resources, nil
+}
+
+// newSSPFromStatus generates the SSP CR for the node architectures in the HyperConverged status
+func newSSPFromStatus(hc *v1beta1.HyperConverged) (*sspv1beta3.SSP, error) {
+	ssp, _, err := handlers.NewSSPForArchitectures(hc, hc.Status.NodeInfo.ControlPlaneArchitectures, hc.Status.NodeInfo.WorkloadsArchitectures)
+	return ssp, err
+}
+
 // ValidateUpdate is the ValidateUpdate webhook implementation. It calls all the resources in parallel, to dry-run the
 // upgrade.
 func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, requested *v1beta1.HyperConverged, exists *v1beta1.HyperConverged) error {
@@ -228,21 +292,47 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		return err
 	}
 
-	if err := wh.validateFeatureGatesOnUpdate(requested, exists); err != nil {
+	if err := validateMonitoring(requested); err != nil {
 		return err
 	}
 
-	if err := wh.validateAffinity(requested); err != nil {
+	if err := validateDeletionConfirmationAnnotations(requested); err != nil {
+		return err
+	}
+
+	warnings, invalidAnnotations, err := wh.validateJSONPatchAnnotations(requested, exists)
+	if err != nil {
+		return err
+	}
+
+	warnings = append(warnings, wh.validateFeatureGatesOnUpdate(requested, exists)...)
+
+	if err = wh.validateAffinity(requested); err != nil {
 		return err
 	}
 
 	// If no change is detected in the spec nor the annotations - nothing to validate
 	if reflect.DeepEqual(exists.Spec, requested.Spec) &&
 		reflect.DeepEqual(exists.Annotations, requested.Annotations) {
-		return nil
+		return newValidationWarningIfAny(warnings)
 	}
 
-	kv, cdi, cna, err := wh.getOperands(requested)
+	ruleWarnings, err := wh.evaluateAdmissionRules(ctx, admissionv1.Update, requested, exists)
+	if err != nil {
+		return err
+	}
+	warnings = append(warnings, ruleWarnings...)
+
+	// the unchanged invalid jsonpatch annotations were already reported; dry-run the operands without them
+	rendered := requested
+	if len(invalidAnnotations) > 0 {
+		rendered = requested.DeepCopy()
+		for _, name := range invalidAnnotations {
+			delete(rendered.Annotations, name)
+		}
+	}
+
+	resources, err := wh.renderOperands(rendered)
 	if err != nil {
 		return err
 	}
@@ -253,38 +343,10 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 	eg, egCtx := xsync.WithContext(toCtx)
 	opts := &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}}
 
-	resources := []client.Object{
-		kv,
-		cdi,
-		cna,
-	}
-
-	if wh.isOpenshift {
-		origGetControlPlaneArchitectures := nodeinfo.GetControlPlaneArchitectures
-		origGetWorkloadsArchitectures := nodeinfo.GetWorkloadsArchitectures
-		defer func() {
-			nodeinfo.GetControlPlaneArchitectures = origGetControlPlaneArchitectures
-			nodeinfo.GetWorkloadsArchitectures = origGetWorkloadsArchitectures
-		}()
-
-		nodeinfo.GetControlPlaneArchitectures = func() []string {
-			return requested.Status.NodeInfo.ControlPlaneArchitectures
-		}
-		nodeinfo.GetWorkloadsArchitectures = func() []string {
-			return requested.Status.NodeInfo.WorkloadsArchitectures
-		}
-
-		ssp, _, err := handlers.NewSSP(requested)
-		if err != nil {
-			return err
-		}
-		resources = append(resources, ssp)
-	}
-
 	for _, obj := range resources {
 		func(o client.Object) {
 			eg.Go(func() error {
-				return wh.updateOperatorCr(egCtx, requested, o, opts)
+				return wh.updateOperatorCr(egCtx, rendered, o, opts)
 			})
 		}(obj)
 	}
@@ -298,7 +360,7 @@ func (wh *WebhookHandler) ValidateUpdate(ctx context.Context, dryrun bool, reque
 		hcoTLSConfigCache = requested.Spec.TLSSecurityProfile
 	}
 
-	return nil
+	return newValidationWarningIfAny(warnings)
 }
 
 func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.HyperConverged, exists client.Object, opts *client.UpdateOptions) error {
@@ -331,7 +393,7 @@ func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.Hype
 		required.Spec.DeepCopyInto(&existing.Spec)
 
 	case *sspv1beta3.SSP:
-		required, _, err := handlers.NewSSP(hc)
+		required, err := newSSPFromStatus(hc)
 		if err != nil {
 			return err
 		}
@@ -350,6 +412,10 @@ func (wh *WebhookHandler) updateOperatorCr(ctx context.Context, hc *v1beta1.Hype
 func (wh *WebhookHandler) ValidateDelete(ctx context.Context, dryrun bool, hc *v1beta1.HyperConverged) error {
 	wh.logger.Info("Validating delete", "name", hc.Name, "namespace", hc.Namespace)
 
+	if err := checkDeletionConfirmation(hc, time.Now()); err != nil {
+		return wh.rejectDeletion(ctx, dryrun, hc, err)
+	}
+
 	kv := handlers.NewKubeVirtWithNameOnly(hc)
 	cdi := handlers.NewCDIWithNameOnly(hc)
 
@@ -360,7 +426,7 @@ func (wh *WebhookHandler) ValidateDelete(ctx context.Context, dryrun bool, hc *v
 		_, err := hcoutil.EnsureDeleted(ctx, wh.cli, obj, hc.Name, wh.logger, true, false, true)
 		if err != nil {
 			wh.logger.Error(err, "Delete validation failed", "GVK", obj.GetObjectKind().GroupVersionKind())
-			return err
+			return wh.rejectDeletion(ctx, dryrun, hc, err)
 		}
 	}
 	if !dryrun {
@@ -458,26 +524,102 @@ const (
 	fgDeprecationWarning = "spec.featureGates.%s is deprecated and ignored. It will be removed in a future version;"
 )
 
-func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *v1beta1.HyperConverged) error {
-	warnings := wh.validateDeprecatedFeatureGates(hc)
-	warnings = validateOldFGOnCreate(warnings, hc)
-
-	if len(warnings) > 0 {
-		return newValidationWarning(warnings)
-	}
+type jsonPatchAnnotation struct {
+	name       string
+	kind       string
+	getOperand func(hc *v1beta1.HyperConverged) (runtime.Object, error)
+}
 
-	return nil
+var jsonPatchAnnotations = []jsonPatchAnnotation{
+	{
+		name: common.JSONPatchKVAnnotationName,
+		kind: "KubeVirt",
+		getOperand: func(hc *v1beta1.HyperConverged) (runtime.Object, error) {
+			return handlers.NewKubeVirt(hc)
+		},
+	},
+	{
+		name: common.JSONPatchCDIAnnotationName,
+		kind: "CDI",
+		getOperand: func(hc *v1beta1.HyperConverged) (runtime.Object, error) {
+			return handlers.NewCDI(hc)
+		},
+	},
+	{
+		name: common.JSONPatchCNAOAnnotationName,
+		kind: "NetworkAddonsConfig",
+		getOperand: func(hc *v1beta1.HyperConverged) (runtime.Object, error) {
+			return handlers.NewNetworkAddons(hc)
+		},
+	},
+	{
+		name: common.JSONPatchSSPAnnotationName,
+		kind: "SSP",
+		getOperand: func(hc *v1beta1.HyperConverged) (runtime.Object, error) {
+			return newSSPFromStatus(hc)
+		},
+	},
 }
 
-func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *v1beta1.HyperConverged) error {
-	warnings := wh.validateDeprecatedFeatureGates(requested)
-	warnings = validateOldFGOnUpdate(warnings, requested, exists)
+// validateJSONPatchAnnotations applies each jsonpatch annotation to the operand CR that HCO would generate without
+// it, and rejects the request if the patch can't be applied, or if it produces an invalid CR. Valid patches are still
+// unsupported, so a warning that lists the modified fields is returned for each new or modified annotation.
+//
+// On update, an annotation that was not modified only produces a warning, even if it is invalid; the patch may become
+// invalid because of an unrelated change, e.g. of the HyperConverged spec or of the operand API, and rejecting the
+// request would block any modification of the HyperConverged CR. The names of these annotations are returned, so the
+// caller can ignore them.
+func (wh *WebhookHandler) validateJSONPatchAnnotations(requested, exists *v1beta1.HyperConverged) ([]string, []string, error) {
+	var warnings, invalidAnnotations []string
+
+	for _, annotation := range jsonPatchAnnotations {
+		patch, ok := requested.Annotations[annotation.name]
+		if !ok {
+			continue
+		}
 
-	if len(warnings) > 0 {
-		return newValidationWarning(warnings)
+		existingPatch, existed := "", false
+		if exists != nil {
+			existingPatch, existed = exists.Annotations[annotation.name]
+		}
+		unchanged := existed && existingPatch == patch
+
+		hc := requested.DeepCopy()
+		delete(hc.Annotations, annotation.name)
+		operand, err := annotation.getOperand(hc)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		paths, err := operands.ValidateAnnotationPatch(operand, patch)
+		if err != nil {
+			if unchanged {
+				wh.logger.Info("an existing jsonPatch annotation is invalid", "annotation", annotation.name, "error", err.Error())
+				warnings = append(warnings, fmt.Sprintf("invalid jsonPatch in the %s annotation: %v", annotation.name, err))
+				invalidAnnotations = append(invalidAnnotations, annotation.name)
+				continue
+			}
+			return nil, nil, fmt.Errorf("invalid jsonPatch in the %s annotation: %w", annotation.name, err)
+		}
+
+		if len(paths) > 0 && !unchanged {
+			warnings = append(warnings, fmt.Sprintf(
+				"the %s annotation modifies the following fields of the %s CR, which is an unsupported configuration: %s",
+				annotation.name, annotation.kind, strings.Join(paths, ", ")))
+		}
 	}
 
-	return nil
+	return warnings, invalidAnnotations, nil
+}
+
+func (wh *WebhookHandler) validateFeatureGatesOnCreate(hc *v1beta1.HyperConverged) []string {
+	warnings := wh.validateDeprecatedFeatureGates(hc)
+	return validateOldFGOnCreate(warnings, hc)
+}
+
+func (wh *WebhookHandler) validateFeatureGatesOnUpdate(requested, exists *v1beta1.HyperConverged) []string {
+	warnings := wh.validateDeprecatedFeatureGates(requested)
+	return validateOldFGOnUpdate(warnings, requested, exists)
 }
 
 func (wh *WebhookHandler) validateDeprecatedFeatureGates(hc *v1beta1.HyperConverged) []string {
@@ -617,3 +759,8 @@ func isValidTLSProtocolVersion(pv openshiftconfigv1.TLSProtocolVersion) bool {
 	}
 	return false
 }
+
+// isHCOServiceAccount checks if the request was sent by HCO itself
+func isHCOServiceAccount(username, namespace string) bool {
+	return username == fmt.Sprintf("system:serviceaccount:%s:%s", namespace, hcoutil.HCOServiceAccountName)
+}
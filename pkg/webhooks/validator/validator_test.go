@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
@@ -34,6 +35,7 @@ import (
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
 	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/nodeinfo"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
 )
 
@@ -51,6 +53,11 @@ func TestValidatorWebhook(t *testing.T) {
 	RunSpecs(t, "Validator Webhooks Suite")
 }
 
+var _ = BeforeSuite(func() {
+	// the webhook emits an event when it rejects the deletion of the HyperConverged CR
+	util.GetEventEmitter().Init(nil, nil, &record.FakeRecorder{})
+})
+
 const (
 	validKvAnnotation = `[
 					{
@@ -198,7 +205,7 @@ var _ = Describe("webhooks validator", func() {
 		},
 			Entry("should accept creation of a resource with a valid kv annotation",
 				map[string]string{common.JSONPatchKVAnnotationName: validKvAnnotation},
-				Succeed(),
+				beJSONPatchWarning(common.JSONPatchKVAnnotationName),
 			),
 			Entry("should reject creation of a resource with an invalid kv annotation",
 				map[string]string{common.JSONPatchKVAnnotationName: invalidKvAnnotation},
@@ -206,7 +213,7 @@ var _ = Describe("webhooks validator", func() {
 			),
 			Entry("should accept creation of a resource with a valid cdi annotation",
 				map[string]string{common.JSONPatchCDIAnnotationName: validCdiAnnotation},
-				Succeed(),
+				beJSONPatchWarning(common.JSONPatchCDIAnnotationName),
 			),
 			Entry("should reject creation of a resource with an invalid cdi annotation",
 				map[string]string{common.JSONPatchCDIAnnotationName: invalidCdiAnnotation},
@@ -214,7 +221,7 @@ var _ = Describe("webhooks validator", func() {
 			),
 			Entry("should accept creation of a resource with a valid cna annotation",
 				map[string]string{common.JSONPatchCNAOAnnotationName: validCnaAnnotation},
-				Succeed(),
+				beJSONPatchWarning(common.JSONPatchCNAOAnnotationName),
 			),
 			Entry("should reject creation of a resource with an invalid cna annotation",
 				map[string]string{common.JSONPatchCNAOAnnotationName: invalidCnaAnnotation},
@@ -222,7 +229,7 @@ var _ = Describe("webhooks validator", func() {
 			),
 			Entry("should accept creation of a resource with a valid ssp annotation",
 				map[string]string{common.JSONPatchSSPAnnotationName: validSspAnnotation},
-				Succeed(),
+				beJSONPatchWarning(common.JSONPatchSSPAnnotationName),
 			),
 			Entry("should reject creation of a resource with an invalid ssp annotation",
 				map[string]string{common.JSONPatchSSPAnnotationName: invalidSspAnnotation},
@@ -230,6 +237,82 @@ var _ = Describe("webhooks validator", func() {
 			),
 		)
 
+		DescribeTable("should reject an invalid jsonpatch annotation with a precise message", func(annotationName, annotation, message string) {
+			cr.Annotations = map[string]string{annotationName: annotation}
+			err := wh.ValidateCreate(ctx, dryRun, cr)
+			Expect(err).To(MatchError(And(
+				ContainSubstring("invalid jsonPatch in the %s annotation", annotationName),
+				ContainSubstring(message),
+			)))
+		},
+			Entry("malformed patch",
+				common.JSONPatchKVAnnotationName,
+				`[{"op": "add", "path": "/spec/configuration/cpuRequest", "value": "12m"`,
+				"invalid state detected",
+			),
+			Entry("non-spec path",
+				common.JSONPatchCDIAnnotationName,
+				`[{"op": "add", "path": "/metadata/labels/aaa", "value": "bbb"}]`,
+				"operation #0 (add /metadata/labels/aaa): can only modify spec fields",
+			),
+			Entry("moving a non-spec field",
+				common.JSONPatchCDIAnnotationName,
+				`[{"op": "move", "from": "/metadata/name", "path": "/spec/config/aaa"}]`,
+				"operation #0 (move /spec/config/aaa): can only copy or move spec fields",
+			),
+			Entry("missing parent",
+				common.JSONPatchCNAOAnnotationName,
+				`[{"op": "add", "path": "/spec/imagePullPolicy", "value": "Always"}, {"op": "add", "path": "/spec/notExist/aaa", "value": "bbb"}]`,
+				"operation #1 (add /spec/notExist/aaa)",
+			),
+			Entry("unknown field",
+				common.JSONPatchKVAnnotationName,
+				`[{"op": "add", "path": "/spec/configuration/notAField", "value": "bbb"}]`,
+				`operation #0 (add /spec/configuration/notAField): json: unknown field "notAField"`,
+			),
+			Entry("wrong type",
+				common.JSONPatchSSPAnnotationName,
+				`[{"op": "replace", "path": "/spec/templateValidator/replicas", "value": "five"}]`,
+				"operation #0 (replace /spec/templateValidator/replicas): json: cannot unmarshal string",
+			),
+			Entry("failed test operation",
+				common.JSONPatchSSPAnnotationName,
+				`[{"op": "test", "path": "/spec/templateValidator/replicas", "value": 7}]`,
+				"operation #0 (test /spec/templateValidator/replicas)",
+			),
+		)
+
+		It("should list the modified fields in the jsonpatch warnings", func() {
+			cr.Annotations = map[string]string{
+				common.JSONPatchKVAnnotationName: validKvAnnotation,
+				common.JSONPatchSSPAnnotationName: `[
+					{"op": "test", "path": "/spec/templateValidator/replicas", "value": 2},
+					{"op": "replace", "path": "/spec/templateValidator/replicas", "value": 5}
+				]`,
+			}
+
+			err := wh.ValidateCreate(ctx, dryRun, cr)
+			vw := &ValidationWarning{}
+			Expect(errors.As(err, &vw)).To(BeTrue())
+			Expect(vw.Warnings()).To(ConsistOf(
+				"the kubevirt.kubevirt.io/jsonpatch annotation modifies the following fields of the KubeVirt CR, which is an unsupported configuration: /spec/configuration/cpuRequest, /spec/configuration/developerConfiguration, /spec/configuration/developerConfiguration/featureGates/-",
+				"the ssp.kubevirt.io/jsonpatch annotation modifies the following fields of the SSP CR, which is an unsupported configuration: /spec/templateValidator/replicas",
+			))
+		})
+
+		It("should return both the jsonpatch and the feature gate warnings", func() {
+			cr.Annotations = map[string]string{common.JSONPatchCNAOAnnotationName: validCnaAnnotation}
+			cr.Spec.FeatureGates = v1beta1.HyperConvergedFeatureGates{WithHostPassthroughCPU: ptr.To(true)}
+
+			err := wh.ValidateCreate(ctx, dryRun, cr)
+			vw := &ValidationWarning{}
+			Expect(errors.As(err, &vw)).To(BeTrue())
+			Expect(vw.Warnings()).To(ConsistOf(
+				ContainSubstring(common.JSONPatchCNAOAnnotationName),
+				ContainSubstring("withHostPassthroughCPU"),
+			))
+		})
+
 		Context("test permitted host devices validation", func() {
 			It("should allow unique PCI Host Device", func() {
 				cr.Spec.PermittedHostDevices = &v1beta1.PermittedHostDevices{
@@ -521,6 +604,19 @@ var _ = Describe("webhooks validator", func() {
 			)
 		})
 
+		It("should reject invalid alert silences", func() {
+			cr.Spec.Monitoring = &v1beta1.MonitoringConfig{
+				Silences: []v1beta1.AlertSilence{{
+					Name:     "maintenance",
+					Matchers: []v1beta1.AlertSilenceMatcher{{Name: "alertname", Value: "(", Operator: v1beta1.AlertSilenceMatchRegex}},
+					Comment:  "planned maintenance",
+				}},
+			}
+
+			err := wh.ValidateCreate(ctx, dryRun, cr)
+			Expect(err).To(MatchError(ContainSubstring("spec.monitoring.silences[0].matchers[0]: invalid regular expression")))
+		})
+
 		Context("validate affinity", func() {
 			It("should allow empty affinity", func() {
 				cr.Spec.Infra.NodePlacement = &sdkapi.NodePlacement{
@@ -1402,6 +1498,8 @@ var _ = Describe("webhooks validator", func() {
 
 		Context("validate deprecated FGs", func() {
 			DescribeTable("should return warning for deprecated feature gate", func(fgs v1beta1.HyperConvergedFeatureGates, fgNames ...string) {
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
 				newHCO := hco.DeepCopy()
 				newHCO.Spec.FeatureGates = fgs
 
@@ -1455,6 +1553,8 @@ var _ = Describe("webhooks validator", func() {
 		Context("validate moved FG on update", func() {
 			//nolint:staticcheck
 			DescribeTable("should return warning for enableApplicationAwareQuota on update", func(newFG, oldFG *bool) {
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
 				newHCO := hco.DeepCopy()
 				hco.Spec.FeatureGates.EnableApplicationAwareQuota = newFG
 				newHCO.Spec.FeatureGates.EnableApplicationAwareQuota = oldFG
@@ -1492,6 +1592,8 @@ var _ = Describe("webhooks validator", func() {
 
 			//nolint:staticcheck
 			DescribeTable("should return warning for enableCommonBootImageImport on update", func(newFG, oldFG *bool) {
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
 				newHCO := hco.DeepCopy()
 				hco.Spec.FeatureGates.EnableCommonBootImageImport = newFG
 				newHCO.Spec.FeatureGates.EnableCommonBootImageImport = oldFG
@@ -1529,6 +1631,8 @@ var _ = Describe("webhooks validator", func() {
 
 			//nolint:staticcheck
 			DescribeTable("should return warning for deployVmConsoleProxy on update", func(newFG, oldFG *bool) {
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
 				newHCO := hco.DeepCopy()
 				hco.Spec.FeatureGates.DeployVMConsoleProxy = newFG
 				newHCO.Spec.FeatureGates.DeployVMConsoleProxy = oldFG
@@ -1781,6 +1885,84 @@ var _ = Describe("webhooks validator", func() {
 			Entry("should reject if cna annotation is invalid", common.JSONPatchCNAOAnnotationName, invalidCnaAnnotation),
 			Entry("should accept if ssp annotation is invalid", common.JSONPatchSSPAnnotationName, invalidSspAnnotation),
 		)
+
+		DescribeTable("should warn only if the annotation is new or modified",
+			func(annotationName, prevAnnotation, annotation string, assertion types.GomegaMatcher) {
+				if prevAnnotation != "" {
+					hco.Annotations = map[string]string{annotationName: prevAnnotation}
+				}
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+				newHco := hco.DeepCopy()
+				newHco.Annotations = map[string]string{annotationName: annotation}
+
+				Expect(wh.ValidateUpdate(context.TODO(), false, newHco, hco)).To(assertion)
+			},
+			Entry("new kv annotation", common.JSONPatchKVAnnotationName, "", validKvAnnotation, beJSONPatchWarning(common.JSONPatchKVAnnotationName)),
+			Entry("modified cdi annotation", common.JSONPatchCDIAnnotationName, validCdiAnnotation, `[{"op": "add", "path": "/spec/config/featureGates/-", "value": "fg2"}]`, beJSONPatchWarning(common.JSONPatchCDIAnnotationName)),
+			Entry("unchanged cna annotation", common.JSONPatchCNAOAnnotationName, validCnaAnnotation, validCnaAnnotation, Succeed()),
+			Entry("unchanged ssp annotation", common.JSONPatchSSPAnnotationName, validSspAnnotation, validSspAnnotation, Succeed()),
+		)
+
+		DescribeTable("should only warn if an unchanged annotation is invalid",
+			func(annotationName, annotation string) {
+				cli := getFakeClient(hco)
+				wh := NewWebhookHandler(logger, cli, decoder, HcoValidNamespace, true, nil)
+
+				hco.Annotations = map[string]string{annotationName: annotation}
+				newHco := hco.DeepCopy()
+				newHco.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = ptr.To[uint32](10)
+
+				err := wh.ValidateUpdate(context.TODO(), false, newHco, hco)
+				vw := &ValidationWarning{}
+				Expect(errors.As(err, &vw)).To(BeTrue())
+				Expect(vw.Warnings()).To(ConsistOf(ContainSubstring("invalid jsonPatch in the %s annotation", annotationName)))
+			},
+			Entry("kv annotation", common.JSONPatchKVAnnotationName, invalidKvAnnotation),
+			Entry("cdi annotation", common.JSONPatchCDIAnnotationName, invalidCdiAnnotation),
+			Entry("cna annotation", common.JSONPatchCNAOAnnotationName, invalidCnaAnnotation),
+		)
+	})
+
+	Context("render the operands", func() {
+		It("should render the SSP CR for the node architectures in the HyperConverged status", func() {
+			origGetControlPlaneArchitectures := nodeinfo.GetControlPlaneArchitectures
+			origGetWorkloadsArchitectures := nodeinfo.GetWorkloadsArchitectures
+			nodeinfo.GetControlPlaneArchitectures = func() []string {
+				return []string{"cluster-cp-arch"}
+			}
+			nodeinfo.GetWorkloadsArchitectures = func() []string {
+				return []string{"cluster-wl-arch"}
+			}
+			DeferCleanup(func() {
+				nodeinfo.GetControlPlaneArchitectures = origGetControlPlaneArchitectures
+				nodeinfo.GetWorkloadsArchitectures = origGetWorkloadsArchitectures
+			})
+
+			hco := commontestutils.NewHco()
+			hco.Status.NodeInfo.ControlPlaneArchitectures = []string{"amd64"}
+			hco.Status.NodeInfo.WorkloadsArchitectures = []string{"amd64", "arm64"}
+
+			wh := NewWebhookHandler(logger, getFakeClient(hco), decoder, HcoValidNamespace, true, nil)
+			resources, err := wh.renderOperands(hco)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(resources).To(ContainElement(WithTransform(func(obj client.Object) *sspv1beta3.Cluster {
+				ssp, ok := obj.(*sspv1beta3.SSP)
+				if !ok {
+					return nil
+				}
+				return ssp.Spec.Cluster
+			}, Equal(&sspv1beta3.Cluster{
+				ControlPlaneArchitectures: []string{"amd64"},
+				WorkloadArchitectures:     []string{"amd64", "arm64"},
+			}))))
+
+			By("not modifying the node architectures of the cluster")
+			Expect(nodeinfo.GetControlPlaneArchitectures()).To(Equal([]string{"cluster-cp-arch"}))
+			Expect(nodeinfo.GetWorkloadsArchitectures()).To(Equal([]string{"cluster-wl-arch"}))
+		})
 	})
 
 	Context("hcoTLSConfigCache", func() {
@@ -2398,3 +2580,13 @@ func newRequest(operation admissionv1.Operation, cr *v1beta1.HyperConverged, enc
 
 	return req
 }
+
+func beJSONPatchWarning(annotationName string) types.GomegaMatcher {
+	return WithTransform(func(err error) []string {
+		vw := &ValidationWarning{}
+		if !errors.As(err, &vw) {
+			return nil
+		}
+		return vw.Warnings()
+	}, ConsistOf(ContainSubstring(annotationName)))
+}
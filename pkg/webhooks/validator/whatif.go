@@ -0,0 +1,277 @@
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/operands"
+	hcoutil "github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const maxWhatIfRequestSize = 1 << 20
+
+// WhatIfResponse is the response of the what-if endpoint
+type WhatIfResponse struct {
+	// Allowed is true if the HyperConverged CR would be accepted by the validating webhook
+	Allowed bool `json:"allowed"`
+	// Message is the reason for rejecting the HyperConverged CR
+	Message string `json:"message,omitempty"`
+	// Warnings are the admission warnings the validating webhook would return
+	Warnings []string `json:"warnings,omitempty"`
+	// Operands are the operand CRs, rendered from the HyperConverged CR
+	Operands []WhatIfOperand `json:"operands,omitempty"`
+}
+
+// WhatIfOperand is an operand CR, rendered from the HyperConverged CR in the what-if request
+type WhatIfOperand struct {
+	Kind      string `json:"kind"`
+	Name      string `json:"name"`
+	Namespace string `json:"namespace,omitempty"`
+	// Spec is the rendered spec of the operand CR
+	Spec any `json:"spec"`
+	// Exists is true if the operand CR already exists in the cluster
+	Exists bool `json:"exists"`
+	// Changes are the modifications of the live operand CR, as returned from a dry-run update with the rendered spec
+	Changes []common.FieldDrift `json:"changes,omitempty"`
+}
+
+// ServeWhatIf handles the what-if requests. The body of the request is a HyperConverged CR, in JSON or in YAML. The
+// CR is validated as by the validating webhook, without modifying anything in the cluster, and the response contains
+// the operand CRs rendered from it, and the modifications they would cause to the live operand CRs.
+//
+// The caller must be allowed to update the HyperConverged CR.
+func (wh *WebhookHandler) ServeWhatIf(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		http.Error(w, "only POST is supported", http.StatusMethodNotAllowed)
+		return
+	}
+
+	ctx := r.Context()
+
+	user, status, err := wh.authorizeWhatIf(ctx, r)
+	if err != nil {
+		http.Error(w, err.Error(), status)
+		return
+	}
+
+	// the admission rules may refer to the requesting user
+	ctx = admission.NewContextWithRequest(ctx, admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{UserInfo: user, DryRun: ptr.To(true)},
+	})
+
+	requested, err := wh.decodeWhatIfRequest(r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	resp, err := wh.whatIf(ctx, requested)
+	if err != nil {
+		wh.logger.Error(err, "failed to process a what-if request")
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err = json.NewEncoder(w).Encode(resp); err != nil {
+		wh.logger.Error(err, "failed to write the what-if response")
+	}
+}
+
+// authorizeWhatIf authenticates the bearer token of the request, and checks that its user may update the
+// HyperConverged CR. Returns the user of the request, or the HTTP status to use if the request is not authorized.
+func (wh *WebhookHandler) authorizeWhatIf(ctx context.Context, r *http.Request) (authenticationv1.UserInfo, int, error) {
+	token, found := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+	if !found || token == "" {
+		return authenticationv1.UserInfo{}, http.StatusUnauthorized, errors.New("missing bearer token")
+	}
+
+	tr := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := wh.cli.Create(ctx, tr); err != nil {
+		wh.logger.Error(err, "failed to review the token of a what-if request")
+		return authenticationv1.UserInfo{}, http.StatusInternalServerError, errors.New("can't authenticate the request")
+	}
+
+	if !tr.Status.Authenticated {
+		return authenticationv1.UserInfo{}, http.StatusUnauthorized, errors.New("invalid bearer token")
+	}
+
+	extra := make(map[string]authorizationv1.ExtraValue, len(tr.Status.User.Extra))
+	for key, value := range tr.Status.User.Extra {
+		extra[key] = authorizationv1.ExtraValue(value)
+	}
+
+	sar := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   tr.Status.User.Username,
+			UID:    tr.Status.User.UID,
+			Groups: tr.Status.User.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: wh.namespace,
+				Verb:      "update",
+				Group:     v1beta1.SchemeGroupVersion.Group,
+				Resource:  "hyperconvergeds",
+				Name:      hcoutil.HyperConvergedName,
+			},
+		},
+	}
+	if err := wh.cli.Create(ctx, sar); err != nil {
+		wh.logger.Error(err, "failed to review the access of a what-if request")
+		return authenticationv1.UserInfo{}, http.StatusInternalServerError, errors.New("can't authorize the request")
+	}
+
+	if !sar.Status.Allowed {
+		return authenticationv1.UserInfo{}, http.StatusForbidden, fmt.Errorf("user %q is not allowed to update the HyperConverged CR", tr.Status.User.Username)
+	}
+
+	return tr.Status.User, http.StatusOK, nil
+}
+
+func (wh *WebhookHandler) decodeWhatIfRequest(r *http.Request) (*v1beta1.HyperConverged, error) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxWhatIfRequestSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > maxWhatIfRequestSize {
+		return nil, errors.New("the request is too large")
+	}
+
+	obj, _, err := serializer.NewCodecFactory(wh.cli.Scheme()).UniversalDeserializer().Decode(body, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("can't read the HyperConverged CR: %w", err)
+	}
+
+	var hc *v1beta1.HyperConverged
+	switch o := obj.(type) {
+	case *v1beta1.HyperConverged:
+		hc = o
+	case *hcov1.HyperConverged:
+		hc = &v1beta1.HyperConverged{}
+		if err = hc.ConvertFrom(o); err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("expected a HyperConverged CR, but got %T", obj)
+	}
+
+	// the request did not pass through the API server, so the CRD defaults were not applied yet
+	v1beta1.SetObjectDefaults_HyperConverged(hc)
+
+	if hc.Namespace == "" {
+		hc.Namespace = wh.namespace
+	}
+
+	if hc.Name == "" {
+		hc.Name = hcoutil.HyperConvergedName
+	}
+
+	return hc, nil
+}
+
+func (wh *WebhookHandler) whatIf(ctx context.Context, requested *v1beta1.HyperConverged) (*WhatIfResponse, error) {
+	exists := &v1beta1.HyperConverged{}
+	err := wh.cli.Get(ctx, client.ObjectKeyFromObject(requested), exists)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		exists = nil
+	}
+
+	if exists != nil {
+		// the status is not part of the request, but the SSP is rendered from it
+		requested.Status = *exists.Status.DeepCopy()
+		err = wh.ValidateUpdate(ctx, true, requested, exists)
+	} else {
+		err = wh.ValidateCreate(ctx, true, requested)
+	}
+
+	resp := &WhatIfResponse{Allowed: true}
+	var vw *ValidationWarning
+	if errors.As(err, &vw) {
+		resp.Warnings = vw.Warnings()
+	} else if err != nil {
+		resp.Allowed = false
+		resp.Message = err.Error()
+		return resp, nil
+	}
+
+	rendered, err := wh.renderOperands(requested)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, obj := range rendered {
+		operand, err := wh.whatIfOperand(ctx, requested, obj)
+		if err != nil {
+			return nil, err
+		}
+		resp.Operands = append(resp.Operands, *operand)
+	}
+
+	return resp, nil
+}
+
+// whatIfOperand dry-runs the update of the live operand CR with the rendered spec, and returns the resulting
+// modifications. The API server defaults are applied by the dry-run, so only actual modifications are reported.
+func (wh *WebhookHandler) whatIfOperand(ctx context.Context, hc *v1beta1.HyperConverged, required client.Object) (*WhatIfOperand, error) {
+	gvk, err := wh.cli.GroupVersionKindFor(required)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(required)
+	if err != nil {
+		return nil, err
+	}
+
+	operand := &WhatIfOperand{
+		Kind:      gvk.Kind,
+		Name:      required.GetName(),
+		Namespace: required.GetNamespace(),
+		Spec:      u["spec"],
+	}
+
+	live := required.DeepCopyObject().(client.Object)
+	if err = hcoutil.GetRuntimeObject(ctx, wh.cli, live); err != nil {
+		if apierrors.IsNotFound(err) {
+			return operand, nil
+		}
+		return nil, err
+	}
+	operand.Exists = true
+
+	updated := required.DeepCopyObject().(client.Object)
+	if err = wh.updateOperatorCr(ctx, hc, updated, &client.UpdateOptions{DryRun: []string{metav1.DryRunAll}}); err != nil {
+		return nil, fmt.Errorf("failed to dry-run update the %s: %w", gvk.Kind, err)
+	}
+
+	if operand.Changes, err = operands.GetFieldDrift(live, updated); err != nil {
+		return nil, err
+	}
+
+	return operand, nil
+}
@@ -0,0 +1,198 @@
+package validator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/common"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/commontestutils"
+	"github.com/kubevirt/hyperconverged-cluster-operator/controllers/handlers"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/util"
+)
+
+const whatIfToken = "valid-token"
+
+var _ = Describe("what-if endpoint", func() {
+	var (
+		hco          *v1beta1.HyperConverged
+		allowedToken bool
+	)
+
+	BeforeEach(func() {
+		hco = commontestutils.NewHco()
+		allowedToken = true
+	})
+
+	newWhatIfHandler := func(objs ...client.Object) *WebhookHandler {
+		cli := fake.NewClientBuilder().
+			WithScheme(commontestutils.GetScheme()).
+			WithObjects(objs...).
+			WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(ctx context.Context, c client.WithWatch, obj client.Object, opts ...client.CreateOption) error {
+					switch review := obj.(type) {
+					case *authenticationv1.TokenReview:
+						review.Status.Authenticated = review.Spec.Token == whatIfToken
+						review.Status.User.Username = "reviewer"
+						return nil
+					case *authorizationv1.SubjectAccessReview:
+						review.Status.Allowed = allowedToken &&
+							review.Spec.User == "reviewer" &&
+							review.Spec.ResourceAttributes.Verb == "update" &&
+							review.Spec.ResourceAttributes.Resource == "hyperconvergeds"
+						return nil
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			}).
+			Build()
+
+		return NewWebhookHandler(logger, cli, admission.NewDecoder(commontestutils.GetScheme()), HcoValidNamespace, false, nil)
+	}
+
+	getOperandObjects := func(hc *v1beta1.HyperConverged) []client.Object {
+		kv, err := handlers.NewKubeVirt(hc)
+		Expect(err).ToNot(HaveOccurred())
+		cdi, err := handlers.NewCDI(hc)
+		Expect(err).ToNot(HaveOccurred())
+		cna, err := handlers.NewNetworkAddons(hc)
+		Expect(err).ToNot(HaveOccurred())
+
+		return []client.Object{hc, kv, cdi, cna}
+	}
+
+	doRequest := func(wh *WebhookHandler, method, token string, body any) *httptest.ResponseRecorder {
+		var reqBody []byte
+		switch b := body.(type) {
+		case string:
+			reqBody = []byte(b)
+		case nil:
+		default:
+			var err error
+			reqBody, err = json.Marshal(b)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		req := httptest.NewRequest(method, util.HCOWhatIfPath, bytes.NewReader(reqBody))
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		rec := httptest.NewRecorder()
+		wh.ServeWhatIf(rec, req)
+		return rec
+	}
+
+	readResponse := func(rec *httptest.ResponseRecorder) *WhatIfResponse {
+		ExpectWithOffset(1, rec.Code).To(Equal(http.StatusOK), rec.Body.String())
+		resp := &WhatIfResponse{}
+		ExpectWithOffset(1, json.Unmarshal(rec.Body.Bytes(), resp)).To(Succeed())
+		return resp
+	}
+
+	It("should reject methods other than POST", func() {
+		rec := doRequest(newWhatIfHandler(), http.MethodGet, whatIfToken, nil)
+		Expect(rec.Code).To(Equal(http.StatusMethodNotAllowed))
+	})
+
+	DescribeTable("should reject unauthorized requests", func(token string, allowed bool, expectedCode int) {
+		allowedToken = allowed
+		rec := doRequest(newWhatIfHandler(), http.MethodPost, token, hco)
+		Expect(rec.Code).To(Equal(expectedCode))
+	},
+		Entry("missing token", "", true, http.StatusUnauthorized),
+		Entry("invalid token", "invalid-token", true, http.StatusUnauthorized),
+		Entry("not allowed to update the HyperConverged CR", whatIfToken, false, http.StatusForbidden),
+	)
+
+	It("should reject a request that is not a HyperConverged CR", func() {
+		rec := doRequest(newWhatIfHandler(), http.MethodPost, whatIfToken, `{"apiVersion": "v1", "kind": "ConfigMap", "metadata": {"name": "cm"}}`)
+		Expect(rec.Code).To(Equal(http.StatusBadRequest))
+		Expect(rec.Body.String()).To(ContainSubstring("expected a HyperConverged CR"))
+	})
+
+	It("should render the operand CRs of a new HyperConverged CR, from YAML", func() {
+		body := `apiVersion: hco.kubevirt.io/v1beta1
+kind: HyperConverged
+metadata:
+  name: kubevirt-hyperconverged
+spec: {}
+`
+		resp := readResponse(doRequest(newWhatIfHandler(), http.MethodPost, whatIfToken, body))
+
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Operands).To(HaveLen(3))
+		for _, operand := range resp.Operands {
+			Expect(operand.Exists).To(BeFalse())
+			Expect(operand.Spec).ToNot(BeNil())
+			Expect(operand.Changes).To(BeEmpty())
+		}
+		Expect(resp.Operands[0].Kind).To(Equal("KubeVirt"))
+		Expect(resp.Operands[1].Kind).To(Equal("CDI"))
+		Expect(resp.Operands[2].Kind).To(Equal("NetworkAddonsConfig"))
+	})
+
+	It("should return the modifications of the live operand CRs", func() {
+		wh := newWhatIfHandler(getOperandObjects(hco)...)
+
+		requested := hco.DeepCopy()
+		requested.Spec.LiveMigrationConfig.ParallelMigrationsPerCluster = ptr.To[uint32](42)
+
+		resp := readResponse(doRequest(wh, http.MethodPost, whatIfToken, requested))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Operands).To(HaveLen(3))
+
+		Expect(resp.Operands[0].Kind).To(Equal("KubeVirt"))
+		Expect(resp.Operands[0].Exists).To(BeTrue())
+		Expect(resp.Operands[0].Changes).To(ConsistOf(common.FieldDrift{
+			Path: "/spec/configuration/migrations/parallelMigrationsPerCluster",
+			Op:   "replace",
+			From: float64(5),
+			To:   float64(42),
+		}))
+
+		for _, operand := range resp.Operands[1:] {
+			Expect(operand.Exists).To(BeTrue())
+			Expect(operand.Changes).To(BeEmpty())
+		}
+
+		By("not modifying the live operand CRs")
+		kv := handlers.NewKubeVirtWithNameOnly(hco)
+		Expect(wh.cli.Get(context.Background(), client.ObjectKeyFromObject(kv), kv)).To(Succeed())
+		Expect(kv.Spec.Configuration.MigrationConfiguration.ParallelMigrationsPerCluster).To(HaveValue(Equal(uint32(5))))
+	})
+
+	It("should return the validation warnings", func() {
+		requested := hco.DeepCopy()
+		requested.Annotations = map[string]string{common.JSONPatchCNAOAnnotationName: validCnaAnnotation}
+
+		resp := readResponse(doRequest(newWhatIfHandler(getOperandObjects(hco)...), http.MethodPost, whatIfToken, requested))
+		Expect(resp.Allowed).To(BeTrue())
+		Expect(resp.Warnings).To(ConsistOf(ContainSubstring(common.JSONPatchCNAOAnnotationName)))
+
+		Expect(resp.Operands[2].Changes).To(ContainElement(HaveField("Path", "/spec/imagePullPolicy")))
+	})
+
+	It("should return the validation error, without rendering the operand CRs", func() {
+		requested := hco.DeepCopy()
+		requested.Annotations = map[string]string{common.JSONPatchKVAnnotationName: invalidKvAnnotation}
+
+		resp := readResponse(doRequest(newWhatIfHandler(getOperandObjects(hco)...), http.MethodPost, whatIfToken, requested))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(resp.Message).To(ContainSubstring("invalid jsonPatch in the %s annotation", common.JSONPatchKVAnnotationName))
+		Expect(resp.Operands).To(BeEmpty())
+	})
+})
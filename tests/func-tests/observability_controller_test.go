@@ -68,7 +68,7 @@ var _ = Describe("Observability Controller", Label(tests.OpenshiftLabel, testNam
 			podDisruptionBudgetAtLimitSilence := observability.FindPodDisruptionBudgetAtLimitSilence(amSilences)
 			Expect(podDisruptionBudgetAtLimitSilence).ToNot(BeNil())
 
-			err = amAPI.DeleteSilence(podDisruptionBudgetAtLimitSilence.ID)
+			err = amAPI.ExpireSilence(podDisruptionBudgetAtLimitSilence.ID)
 			Expect(err).ToNot(HaveOccurred())
 
 			// Restart pod to force reconcile (reconcile periodicity is 1h)
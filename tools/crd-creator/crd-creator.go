@@ -9,6 +9,7 @@ import (
 	"golang.org/x/tools/go/packages"
 	extv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	crdgen "sigs.k8s.io/controller-tools/pkg/crd"
 	crdmarkers "sigs.k8s.io/controller-tools/pkg/crd/markers"
 	"sigs.k8s.io/controller-tools/pkg/loader"
@@ -22,6 +23,12 @@ import (
 const (
 	objectType = "object"
 	importPath = "github.com/kubevirt/hyperconverged-cluster-operator/api/..."
+
+	// the conversion webhook of the non-OLM deployment; OLM replaces it with the conversion webhook of the CSV
+	webhookNamespace   = "kubevirt-hyperconverged"
+	webhookServiceName = "hyperconverged-cluster-webhook-service"
+	webhookCertName    = "hyperconverged-cluster-webhook-service-cert"
+	injectCAAnnotation = "cert-manager.io/inject-ca-from"
 )
 
 var (
@@ -106,9 +113,36 @@ func getOperatorCRD() (*extv1.CustomResourceDefinition, error) {
 			},
 		}
 	}
+
+	setConversionWebhook(&c)
+
 	return &c, nil
 }
 
+// setConversionWebhook sets the conversion webhook of the CRD, with the CA injected by cert-manager, so the v1beta1
+// objects are converted by HCO also when it is not deployed by OLM.
+func setConversionWebhook(crd *extv1.CustomResourceDefinition) {
+	if crd.Annotations == nil {
+		crd.Annotations = map[string]string{}
+	}
+	crd.Annotations[injectCAAnnotation] = webhookNamespace + "/" + webhookCertName
+
+	crd.Spec.Conversion = &extv1.CustomResourceConversion{
+		Strategy: extv1.WebhookConverter,
+		Webhook: &extv1.WebhookConversion{
+			ClientConfig: &extv1.WebhookClientConfig{
+				Service: &extv1.ServiceReference{
+					Namespace: webhookNamespace,
+					Name:      webhookServiceName,
+					Path:      ptr.To(hcoutil.HCOConvertWebhookPath),
+					Port:      ptr.To[int32](hcoutil.WebhookPort),
+				},
+			},
+			ConversionReviewVersions: []string{"v1", "v1beta1"},
+		},
+	}
+}
+
 func packageErrors(pkg *loader.Package, filterKinds ...packages.ErrorKind) error {
 	toSkip := make(map[packages.ErrorKind]struct{})
 	for _, errKind := range filterKinds {
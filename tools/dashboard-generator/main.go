@@ -0,0 +1,28 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/dashboard"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+)
+
+func main() {
+	err := metrics.SetupMetrics()
+	if err != nil {
+		panic(err)
+	}
+
+	err = rules.SetupRules()
+	if err != nil {
+		panic(err)
+	}
+
+	cm, err := dashboard.BuildConfigMap(metrics.ListMetrics(), rules.ListRecordingRules())
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(string(cm))
+}
@@ -2,11 +2,17 @@ package main
 
 import (
 	"fmt"
+	"os"
+	"slices"
+	"strings"
+	"text/template"
 
 	"github.com/machadovilaca/operator-observability/pkg/docs"
+	promv1 "github.com/prometheus-operator/prometheus-operator/pkg/apis/monitoring/v1"
 
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/metrics"
 	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/hyperconverged/rules"
+	observabilityrules "github.com/kubevirt/hyperconverged-cluster-operator/pkg/monitoring/observability/rules"
 )
 
 const tpl = `# Hyperconverged Cluster Operator metrics
@@ -26,6 +32,22 @@ const tpl = `# Hyperconverged Cluster Operator metrics
 ### {{ .Name }}
 {{ print $stabilityLevel }}{{ .Help }}. Type: {{ .Type -}}.
 
+{{- end }}
+`
+
+const alertsTpl = `
+## Alert runbooks
+
+The runbook_url annotation of each alert points to its runbook. The
+spec.monitoring.runbookBaseURL field of the HyperConverged CR replaces the base
+URL of all the runbooks; the runbook of each alert is then the base URL,
+followed by the name of the alert. The runbookURL field of an alert override in
+spec.monitoring.alerts replaces the runbook of a single alert.
+
+| Alert | Severity | Default runbook |
+|-------|----------|-----------------|
+{{- range . }}
+| {{ .Alert }} | {{ index .Labels "severity" }} | {{ index .Annotations "runbook_url" }} |
 {{- end }}
 
 ## Developing new metrics
@@ -46,9 +68,24 @@ func main() {
 		panic(err)
 	}
 
+	err = observabilityrules.SetupRules()
+	if err != nil {
+		panic(err)
+	}
+
 	metricsList := metrics.ListMetrics()
 	rulesList := rules.ListRecordingRules()
 
 	docsString := docs.BuildMetricsDocsWithCustomTemplate(metricsList, rulesList, tpl)
 	fmt.Print(docsString)
+
+	alerts := slices.Concat(rules.ListAlerts(), observabilityrules.ListAlerts())
+	slices.SortStableFunc(alerts, func(a, b promv1.Rule) int {
+		return strings.Compare(a.Alert, b.Alert)
+	})
+
+	err = template.Must(template.New("alerts").Parse(alertsTpl)).Execute(os.Stdout, alerts)
+	if err != nil {
+		panic(err)
+	}
 }
@@ -0,0 +1,280 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/blang/semver/v4"
+	"github.com/go-logr/logr"
+	"gomodules.xyz/jsonpatch/v2"
+	"sigs.k8s.io/yaml"
+
+	hcov1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1"
+	hcov1beta1 "github.com/kubevirt/hyperconverged-cluster-operator/api/v1beta1"
+	"github.com/kubevirt/hyperconverged-cluster-operator/pkg/upgradepatch"
+	"github.com/kubevirt/hyperconverged-cluster-operator/tools/util"
+)
+
+var (
+	crFile                    string
+	fromVersion               string
+	upgradePatchesFile        string
+	outputCRFile              string
+	isOpenshift               bool
+	crds                      string
+	controlPlaneArchitectures string
+	workloadsArchitectures    string
+)
+
+func init() {
+	flag.StringVar(&crFile, "cr", "", "path to the HyperConverged CR YAML file (required)")
+	flag.StringVar(&fromVersion, "from-version", "", "the HCO version to simulate the upgrade from (required)")
+	flag.StringVar(&upgradePatchesFile, "upgrade-patches", "assets/upgradePatches.json", "path to the upgradePatches.json file")
+	flag.StringVar(&outputCRFile, "output-cr", "", "optional path to write the patched HyperConverged CR YAML to")
+	flag.BoolVar(&isOpenshift, "openshift", false, "simulate an upgrade on an OpenShift cluster")
+	flag.StringVar(&crds, "crds", "", "comma separated list of the names of the CRDs that exist in the simulated cluster")
+	flag.StringVar(&controlPlaneArchitectures, "control-plane-architectures", "", "comma separated list of the CPU architectures of the simulated control-plane nodes")
+	flag.StringVar(&workloadsArchitectures, "workloads-architectures", "", "comma separated list of the CPU architectures of the simulated workloads nodes")
+
+	flag.Parse()
+
+	if crFile == "" {
+		printUsageAndErrorAndExit("the --cr parameter is required")
+	}
+
+	if fromVersion == "" {
+		printUsageAndErrorAndExit("the --from-version parameter is required")
+	}
+}
+
+func main() {
+	knownHcoSV, err := semver.ParseTolerant(fromVersion)
+	if err != nil {
+		printErrorAndExit("wrong --from-version parameter: %v", err)
+	}
+
+	hc, err := readHyperConverged(crFile)
+	if err != nil {
+		printErrorAndExit("can't read the HyperConverged CR from %s: %v", crFile, err)
+	}
+
+	patches, err := upgradepatch.ReadUpgradePatches(upgradePatchesFile)
+	if err != nil {
+		printErrorAndExit("can't read the upgrade patches from %s: %v", upgradePatchesFile, err)
+	}
+
+	patchedHC, results, err := patches.ApplyUpgradePatch(logr.Discard(), hc, knownHcoSV, getClusterFacts())
+	if err != nil {
+		printErrorAndExit("failed to apply the upgrade patches: %v", err)
+	}
+
+	specPatch, err := getSpecDiff(hc, patchedHC)
+	if err != nil {
+		printErrorAndExit("failed to compare the HyperConverged CRs: %v", err)
+	}
+
+	var toBeRemoved []upgradepatch.ObjectToBeRemoved
+	for _, obj := range patches.ObjectsToBeRemoved {
+		if obj.IsAffectedRange(knownHcoSV) {
+			toBeRemoved = append(toBeRemoved, obj)
+		}
+	}
+
+	var removalRules []upgradepatch.ObjectsRemovalRule
+	for _, rule := range patches.ObjectsRemovalRules {
+		if rule.IsAffectedRange(knownHcoSV) {
+			removalRules = append(removalRules, rule)
+		}
+	}
+
+	printReport(os.Stdout, knownHcoSV, results, specPatch, toBeRemoved, removalRules)
+
+	if outputCRFile != "" {
+		if err = writeHyperConverged(outputCRFile, patchedHC); err != nil {
+			printErrorAndExit("can't write the patched HyperConverged CR to %s: %v", outputCRFile, err)
+		}
+	}
+}
+
+func readHyperConverged(fileName string) (*hcov1beta1.HyperConverged, error) {
+	crBytes, err := os.ReadFile(fileName)
+	if err != nil {
+		return nil, err
+	}
+
+	crJSON, err := yaml.YAMLToJSON(crBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	hc := &hcov1beta1.HyperConverged{}
+	if err = json.Unmarshal(crJSON, hc); err != nil {
+		return nil, err
+	}
+
+	switch hc.APIVersion {
+	case hcov1beta1.SchemeGroupVersion.String(), "":
+		return hc, nil
+
+	case hcov1.SchemeGroupVersion.String():
+		hub := &hcov1.HyperConverged{}
+		if err = json.Unmarshal(crJSON, hub); err != nil {
+			return nil, err
+		}
+
+		hc = &hcov1beta1.HyperConverged{}
+		if err = hc.ConvertFrom(hub); err != nil {
+			return nil, err
+		}
+		return hc, nil
+	}
+
+	return nil, fmt.Errorf("unsupported apiVersion %q", hc.APIVersion)
+}
+
+func writeHyperConverged(fileName string, hc *hcov1beta1.HyperConverged) error {
+	out, err := os.Create(fileName)
+	if err != nil {
+		return err
+	}
+	defer out.Close()
+
+	return util.MarshallObject(hc, out)
+}
+
+func getClusterFacts() upgradepatch.ClusterFacts {
+	existingCRDs := splitList(crds)
+
+	return upgradepatch.ClusterFacts{
+		IsOpenshift:               isOpenshift,
+		ControlPlaneArchitectures: splitList(controlPlaneArchitectures),
+		WorkloadsArchitectures:    splitList(workloadsArchitectures),
+		CRDExists: func(crdName string) (bool, error) {
+			return slices.Contains(existingCRDs, crdName), nil
+		},
+	}
+}
+
+func getSpecDiff(orig, patched *hcov1beta1.HyperConverged) ([]jsonpatch.Operation, error) {
+	origJSON, err := json.Marshal(orig)
+	if err != nil {
+		return nil, err
+	}
+
+	patchedJSON, err := json.Marshal(patched)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := jsonpatch.CreatePatch(origJSON, patchedJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	// upgrade patches may only modify the spec
+	ops = slices.DeleteFunc(ops, func(op jsonpatch.Operation) bool {
+		return !strings.HasPrefix(op.Path, "/spec/")
+	})
+
+	slices.SortStableFunc(ops, func(a, b jsonpatch.Operation) int {
+		return strings.Compare(a.Path, b.Path)
+	})
+
+	return ops, nil
+}
+
+func printReport(w io.Writer, knownHcoSV semver.Version, results []hcov1beta1.UpgradePatchResult, specPatch []jsonpatch.Operation, toBeRemoved []upgradepatch.ObjectToBeRemoved, removalRules []upgradepatch.ObjectsRemovalRule) {
+	fmt.Fprintf(w, "Upgrade patches, when upgrading from version %s:\n", knownHcoSV)
+	if len(results) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, res := range results {
+		fmt.Fprintf(w, "  [%d] %q: %s", res.Index, res.SemverRange, res.Outcome)
+		if res.Message != "" {
+			fmt.Fprintf(w, " - %s", res.Message)
+		}
+		fmt.Fprintln(w)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "HyperConverged CR changes:")
+	if len(specPatch) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, op := range specPatch {
+		if op.Operation == "remove" {
+			fmt.Fprintf(w, "  %s %s\n", op.Operation, op.Path)
+			continue
+		}
+
+		value, err := json.Marshal(op.Value)
+		if err != nil {
+			value = []byte("<unknown>")
+		}
+		fmt.Fprintf(w, "  %s %s: %s\n", op.Operation, op.Path, value)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Objects to be deleted:")
+	if len(toBeRemoved) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, obj := range toBeRemoved {
+		name := obj.ObjectKey.Name
+		if obj.ObjectKey.Namespace != "" {
+			name = obj.ObjectKey.Namespace + "/" + name
+		}
+		fmt.Fprintf(w, "  - %s %s (%s)\n", obj.GroupVersionKind.Kind, name, obj.GroupVersionKind.GroupVersion())
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Objects removal rules:")
+	if len(removalRules) == 0 {
+		fmt.Fprintln(w, "  none")
+	}
+	for _, rule := range removalRules {
+		fmt.Fprintf(w, "  - %s (%s)", rule.GroupVersionKind.Kind, rule.GroupVersionKind.GroupVersion())
+		if selector, err := rule.GetSelector(); err == nil && !selector.Empty() {
+			fmt.Fprintf(w, ", labels: %s", selector)
+		}
+		if rule.NamePrefix != "" {
+			fmt.Fprintf(w, ", name prefix: %q", rule.NamePrefix)
+		}
+		if len(rule.Namespaces) > 0 {
+			fmt.Fprintf(w, ", namespaces: %s", strings.Join(rule.Namespaces, ","))
+		}
+		if rule.IncludeNonHCOObjects {
+			fmt.Fprint(w, ", including non-HCO objects")
+		}
+		if rule.DryRun {
+			fmt.Fprint(w, " (dry-run)")
+		}
+		fmt.Fprintln(w)
+	}
+}
+
+func splitList(list string) []string {
+	var items []string
+	for item := range strings.SplitSeq(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+func printUsageAndErrorAndExit(msg string) {
+	fmt.Fprintln(os.Stderr, msg)
+	flag.Usage()
+	os.Exit(1)
+}
+
+func printErrorAndExit(template string, args ...any) {
+	fmt.Fprintf(os.Stderr, template+"\n", args...)
+	os.Exit(1)
+}